@@ -0,0 +1,88 @@
+package getlang
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// CleanFlags selects the noise a Detector removes before scoring
+type CleanFlags int
+
+const (
+	// CleanURLs removes web addresses
+	CleanURLs CleanFlags = 1 << iota
+	// CleanEmails removes email addresses
+	CleanEmails
+	// CleanMentions removes @mentions
+	CleanMentions
+	// CleanHashtags removes #hashtags
+	CleanHashtags
+	// CleanNumbers removes numbers, including their separators
+	CleanNumbers
+	// CleanEmoji removes emoji, skin tone modifiers and flags
+	CleanEmoji
+	// CleanSymbols removes other math, currency and miscellaneous symbols
+	CleanSymbols
+
+	// CleanAll removes everything above; it suits social media text
+	CleanAll = CleanURLs | CleanEmails | CleanMentions | CleanHashtags | CleanNumbers | CleanEmoji | CleanSymbols
+)
+
+// cleaners run in order so that an email is not mistaken for a mention
+var cleaners = []struct {
+	flag    CleanFlags
+	pattern *regexp.Regexp
+}{
+	{CleanURLs, regexp.MustCompile(`(?i)\b(?:[a-z][a-z0-9+.-]*://|www\.)\S+`)},
+	{CleanEmails, regexp.MustCompile(`[\p{L}\p{N}._%+-]+@[\p{L}\p{N}-]+(?:\.[\p{L}\p{N}-]+)+`)},
+	{CleanMentions, regexp.MustCompile(`@[\p{L}\p{N}_]+`)},
+	{CleanHashtags, regexp.MustCompile(`#[\p{L}\p{N}_]+`)},
+	{CleanNumbers, regexp.MustCompile(`\p{N}+(?:[.,:/-]\p{N}+)*`)},
+}
+
+var emoji = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x20e3, Hi: 0x20e3, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27bf, Stride: 1},
+		{Lo: 0x2b00, Hi: 0x2bff, Stride: 1},
+		{Lo: 0xfe0e, Hi: 0xfe0f, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1faff, Stride: 1},
+		{Lo: 0xe0020, Hi: 0xe007f, Stride: 1},
+	},
+}
+
+// clean blanks out the noise selected by flags
+func clean(text string, flags CleanFlags) string {
+	for _, c := range cleaners {
+		if flags&c.flag != 0 {
+			text = c.pattern.ReplaceAllString(text, " ")
+		}
+	}
+
+	if flags&(CleanEmoji|CleanSymbols) == 0 {
+		return text
+	}
+	return strings.Map(func(r rune) rune {
+		if flags&CleanEmoji != 0 && unicode.Is(emoji, r) {
+			return ' '
+		}
+		if flags&CleanSymbols != 0 && unicode.IsSymbol(r) {
+			return ' '
+		}
+		return r
+	}, text)
+}
+
+// scoredChars counts the characters that take part in scoring
+func scoredChars(text string) int {
+	var n int
+	for _, r := range text {
+		if !isWordSeparator(r) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,42 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const tweet = "@marie_d78 regarde ça 😂😂 https://t.co/Xy12AbCdEf #DevOps #Go2024 j'ai payé 12,50 € 👍🏽 écris-moi à marie.dupont@example.com"
+
+func TestCleanAll(t *testing.T) {
+	assert.Equal(t, "regarde ça j'ai payé écris-moi à", words(clean(tweet, CleanAll)))
+}
+
+func TestCleanFlagsAreIndependent(t *testing.T) {
+	assert.Equal(t, "and #tag", words(clean("@user and #tag", CleanMentions)))
+	assert.Equal(t, "@user and", words(clean("@user and #tag", CleanHashtags)))
+	assert.Equal(t, "mail now", words(clean("mail bob@example.org now", CleanEmails|CleanMentions)))
+	assert.Equal(t, "see or", words(clean("see www.example.com or http://a.b/c?d=1", CleanURLs)))
+	assert.Equal(t, "apples", words(clean("1,000.5 apples", CleanNumbers)))
+	assert.Equal(t, "ok", words(clean("ok 🇫🇷 ✔️ 1️⃣", CleanEmoji|CleanNumbers)))
+	assert.Equal(t, "5 x", words(clean("5€ + x", CleanSymbols)))
+}
+
+func TestDetectorCleansTweet(t *testing.T) {
+	info, err := Detector{Clean: CleanAll}.FromString(tweet)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "fr", info.LanguageCode())
+	assert.Equal(t, 25, info.ScoredChars())
+	assert.True(t, FromString(tweet).ScoredChars() > info.ScoredChars())
+}
+
+func TestScoredChars(t *testing.T) {
+	assert.Equal(t, 0, FromString("").ScoredChars())
+	assert.Equal(t, 0, FromString(" ... !? ").ScoredChars())
+	assert.Equal(t, 10, FromString("Hello, world!").ScoredChars())
+}
+
+func words(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
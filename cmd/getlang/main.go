@@ -0,0 +1,118 @@
+// Command getlang detects the language of text read from files or standard input
+//
+// Usage:
+//
+//	getlang [-lines] [-window n] [-clean] [-json] [file ...]
+//
+// By default the whole input is classified and printed as "code<TAB>confidence".
+// With -lines every non-blank line is printed as
+// "file:line<TAB>code<TAB>confidence<TAB>text". With -json every result is
+// printed as a JSON object on its own line instead, holding the file, the line
+// number and text with -lines, and the Info
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/rylans/getlang"
+	"io"
+	"os"
+)
+
+// options are the output flags of the command
+type options struct {
+	lines bool
+	json  bool
+}
+
+// result is the JSON form of one result
+type result struct {
+	File string       `json:"file"`
+	Line int          `json:"line,omitempty"`
+	Text string       `json:"text,omitempty"`
+	Info getlang.Info `json:"info"`
+}
+
+func main() {
+	var opts options
+	flag.BoolVar(&opts.lines, "lines", false, "detect the language of each line")
+	window := flag.Int("window", 0, "with -lines, group short lines until they hold this many letters")
+	clean := flag.Bool("clean", false, "remove URLs, handles, numbers and emoji before detection")
+	flag.BoolVar(&opts.json, "json", false, "print each result as a JSON object")
+	flag.Parse()
+
+	detector := getlang.Detector{LineWindow: *window}
+	if *clean {
+		detector.Clean = getlang.CleanAll
+	}
+
+	names := flag.Args()
+	if len(names) == 0 {
+		names = []string{"-"}
+	}
+
+	status := 0
+	for _, name := range names {
+		if err := run(detector, name, opts, os.Stdin, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, "getlang:", err)
+			status = 1
+		}
+	}
+	os.Exit(status)
+}
+
+// run detects the language of the named file, or of stdin if the name is "-"
+func run(detector getlang.Detector, name string, opts options, stdin io.Reader, out io.Writer) error {
+	in := stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	if opts.lines {
+		return detectLines(detector, name, opts, in, out)
+	}
+
+	info, err := detector.FromReader(in)
+	if err != nil {
+		return err
+	}
+	if opts.json {
+		return printJSON(out, result{File: name, Info: info})
+	}
+	_, err = fmt.Fprintf(out, "%s\t%.4f\n", info.LanguageCode(), info.Confidence())
+	return err
+}
+
+// detectLines prints each result as soon as DetectLineText reports it
+func detectLines(detector getlang.Detector, name string, opts options, in io.Reader, out io.Writer) error {
+	var printErr error
+	err := detector.DetectLineText(in, func(lineNo int, text string, info getlang.Info) {
+		if printErr != nil {
+			return
+		}
+		if opts.json {
+			printErr = printJSON(out, result{File: name, Line: lineNo, Text: text, Info: info})
+			return
+		}
+		_, printErr = fmt.Fprintf(out, "%s:%d\t%s\t%.4f\t%s\n", name, lineNo, info.LanguageCode(), info.Confidence(), text)
+	})
+	if err == nil {
+		err = printErr
+	}
+	return err
+}
+
+func printJSON(out io.Writer, r result) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(out, "%s\n", b)
+	return err
+}
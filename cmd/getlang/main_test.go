@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/rylans/getlang"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const corpus = "Alle Menschen sind frei und gleich an Würde und Rechten geboren.\n" +
+	"\n" +
+	"Tous les êtres humains naissent libres et égaux en dignité et en droits.\r\n" +
+	"All human beings are born free and equal in dignity and rights."
+
+func TestRunWholeInput(t *testing.T) {
+	var out bytes.Buffer
+	err := run(getlang.Detector{}, "-", options{}, strings.NewReader("this is the language"), &out)
+
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(out.String(), "en\t"))
+}
+
+func TestRunLines(t *testing.T) {
+	var out bytes.Buffer
+	err := run(getlang.Detector{}, "-", options{lines: true}, strings.NewReader(corpus), &out)
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(lines))
+	assert.True(t, strings.HasPrefix(lines[0], "-:1\tde\t"))
+	assert.True(t, strings.HasPrefix(lines[1], "-:3\tfr\t"))
+	assert.True(t, strings.HasSuffix(lines[1], "\tTous les êtres humains naissent libres et égaux en dignité et en droits."))
+	assert.True(t, strings.HasSuffix(lines[2], "\tAll human beings are born free and equal in dignity and rights."))
+}
+
+func TestRunLinesWindowJSON(t *testing.T) {
+	var out bytes.Buffer
+	detector := getlang.Detector{LineWindow: 20}
+	err := run(detector, "-", options{lines: true, json: true}, strings.NewReader("Menschen\nWürde\nRechten\n\nhumains\n"), &out)
+	assert.Nil(t, err)
+
+	var results []result
+	decoder := json.NewDecoder(&out)
+	for decoder.More() {
+		var r result
+		assert.Nil(t, decoder.Decode(&r))
+		results = append(results, r)
+	}
+
+	assert.Equal(t, 4, len(results))
+	assert.Equal(t, []int{1, 2, 3, 5}, []int{results[0].Line, results[1].Line, results[2].Line, results[3].Line})
+	assert.Equal(t, "Würde", results[1].Text)
+	assert.Equal(t, "-", results[1].File)
+	assert.Equal(t, results[0].Info, results[2].Info)
+	assert.NotEqual(t, results[2].Info.ScoredChars(), results[3].Info.ScoredChars())
+}
+
+func TestRunWholeInputJSON(t *testing.T) {
+	var out bytes.Buffer
+	err := run(getlang.Detector{}, "-", options{json: true}, strings.NewReader("this is the language"), &out)
+	assert.Nil(t, err)
+
+	var r result
+	assert.Nil(t, json.Unmarshal(out.Bytes(), &r))
+	assert.Equal(t, "en", r.Info.LanguageCode())
+	assert.Equal(t, 0, r.Line)
+}
+
+func TestRunMissingFile(t *testing.T) {
+	var out bytes.Buffer
+	err := run(getlang.Detector{}, "does-not-exist.txt", options{}, strings.NewReader(""), &out)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "", out.String())
+}
@@ -0,0 +1,110 @@
+// Code generated by makeprofiles.go; DO NOT EDIT.
+
+package getlang
+
+// corpusProfiles holds the n-gram profiles built from gettext catalogs,
+// indexed by order and language, most frequent first
+var corpusProfiles = map[int]map[string][]string{
+	1: {
+		"ca":      {"e", "a", "s", "i", "r", "t", "n", "o", "l", "c", "d", "u", "p", "m", "f", "b", "g", "x", "v", "h", "q", "ó", "à", "é", "í", "è", "j", "z", "ò", "y", "ú", "k", "ç", "w", "ï", "ü", "á", "ë", "ö", "â"},
+		"cs":      {"o", "e", "n", "a", "t", "s", "p", "r", "v", "l", "i", "u", "k", "í", "d", "z", "á", "m", "c", "b", "y", "j", "h", "ř", "č", "é", "ý", "ž", "ě", "š", "g", "f", "ů", "x", "ú", "w", "ó", "ň", "q", "ť"},
+		"de":      {"e", "n", "i", "t", "r", "s", "a", "d", "l", "h", "u", "g", "o", "c", "m", "b", "f", "k", "p", "z", "w", "v", "ü", "ä", "y", "ö", "x", "j", "ß", "q", "í", "á", "é", "ā", "ó", "ò", "ī", "č", "ú", "ë"},
+		"en":      {"e", "t", "a", "n", "i", "o", "r", "s", "l", "d", "c", "u", "m", "p", "h", "g", "f", "b", "y", "w", "k", "v", "x", "z", "j", "q", "á", "é", "í", "ā", "ī", "ó", "ə", "č", "ž", "ú", "ü", "ñ", "ý", "ã"},
+		"es":      {"e", "a", "o", "r", "i", "n", "s", "d", "c", "l", "t", "u", "p", "m", "b", "f", "g", "v", "ó", "h", "á", "q", "y", "í", "j", "z", "x", "ú", "k", "é", "ñ", "w", "ü", "ò", "º", "å", "ë", "ʻ", "ä", "ç"},
+		"fr":      {"e", "i", "a", "r", "n", "s", "t", "o", "u", "l", "d", "c", "p", "m", "é", "f", "g", "b", "h", "v", "q", "x", "y", "k", "à", "è", "j", "z", "ê", "w", "ô", "î", "ç", "â", "á", "ï", "í", "û", "œ", "ó"},
+		"gl":      {"a", "e", "o", "i", "n", "r", "s", "t", "c", "d", "l", "u", "p", "m", "b", "g", "f", "h", "v", "ó", "x", "q", "á", "é", "í", "z", "ú", "k", "y", "w", "ñ", "j", "ü", "å", "è", "ë", "ð", "đ", "ĺ", "ś"},
+		"hr":      {"a", "i", "e", "o", "n", "r", "s", "t", "k", "j", "p", "u", "d", "l", "m", "v", "z", "b", "c", "g", "č", "š", "f", "h", "ž", "ć", "đ", "y", "w", "x", "ȏ", "q", "ô", "é", "á", "å", "ŋ", "â", "ð", "ó"},
+		"hu":      {"e", "a", "t", "s", "l", "n", "k", "r", "i", "o", "z", "á", "m", "é", "g", "v", "h", "y", "b", "d", "c", "p", "u", "f", "j", "ó", "í", "ö", "ő", "ü", "ú", "ű", "x", "w", "q", "ā", "ä", "đ", "ī", "å"},
+		"id":      {"a", "n", "i", "e", "t", "k", "r", "u", "d", "s", "l", "g", "m", "p", "b", "o", "h", "c", "y", "f", "j", "v", "w", "x", "q", "z", "é", "ë", "ç", "å", "á", "â", "í", "ð", "đ", "ı", "ū", "ʻ"},
+		"it":      {"i", "e", "a", "o", "n", "t", "r", "l", "s", "c", "d", "u", "m", "p", "g", "v", "f", "b", "h", "z", "k", "y", "è", "q", "w", "à", "x", "j", "é", "ò", "ù", "á", "í", "ì", "ó", "ú", "ü", "ã", "å", "ë"},
+		"ja":      {"定", "語", "用", "数", "使", "行", "名", "効", "無", "表", "鍵", "力", "指", "字", "文", "合", "示", "失", "出", "不", "作", "成", "時", "書", "場", "要", "証", "変", "敗", "一", "再", "込", "明", "告", "設", "置", "入", "警", "対", "更", "中", "内", "取", "認", "動", "実", "署", "除", "必", "配", "有", "式", "終", "読", "号", "前", "引", "了", "開", "共", "最", "可", "値", "得", "国", "同", "期", "正", "新", "削", "見", "列", "関", "付", "在", "当", "存", "番", "間", "切", "大", "後", "的", "能", "準", "形", "検", "信", "和", "子", "全", "以", "照", "生", "標", "複", "先", "報", "視", "外", "長", "接", "参", "義", "上", "現", "化", "換", "分", "法", "情", "意", "限", "解", "性", "方", "通", "記", "部", "下", "返", "加", "目", "索", "位", "暗", "予", "発", "続", "者", "代", "区", "保", "命", "日", "空", "選", "個", "始", "理", "利", "覧", "止", "追", "制", "含", "令", "割", "択", "致", "所", "自", "常", "各", "小", "整", "注", "本", "扱", "応", "確", "囲", "算", "初", "範", "受", "適", "型", "優", "別", "進", "重", "未", "様", "求", "状", "識", "非", "例", "態", "互", "回", "度", "密", "持", "起", "際", "決", "頭", "島", "構", "特", "許", "尾", "組", "送", "域", "連", "順", "与", "辞", "釈", "項", "異", "秘", "端", "計", "領", "完", "改", "英", "他", "副", "公", "単", "古", "問", "替", "結", "価", "仮", "刻", "相", "人", "件", "末", "処", "属", "張", "拡", "操", "呼", "探", "条", "済", "誤", "多", "待", "想", "機", "次", "演", "環", "述", "主", "容", "御", "果", "査", "浮", "白", "復", "依", "違", "停", "南", "味", "修", "展", "州", "等", "元", "境", "幅", "答", "規", "移", "管", "素", "縮", "両", "功", "試", "諸", "題", "何", "北", "右", "圧", "族", "既", "来", "詳", "点", "安", "係", "壊", "帰", "装", "超", "過", "併", "去", "強", "秒", "言", "手", "破", "電", "略", "評", "量", "黙", "倍", "十", "左", "東", "比", "省", "種", "細", "護", "較", "並", "今", "基", "専", "疑", "身", "似", "害", "提", "民", "補", "音", "閉", "印", "局", "戻", "押", "繰", "詰", "類", "及", "序", "庫", "断", "直", "禁", "説", "遅", "二", "供", "側", "備", "権", "殊", "足", "知", "立", "集", "体", "少", "抑", "棄", "残", "由", "約", "製", "調", "挿", "短", "辿", "阻", "固", "埋", "己", "王", "精", "負", "跡", "邦", "余", "否", "延", "毎", "消", "渡", "留", "般", "析", "極", "考", "西", "象", "低", "候", "平", "廃", "月", "画", "競", "緒", "降", "影", "拒", "源", "物", "符", "経", "統", "絶", "総", "週", "達", "録", "離", "響", "高", "会", "危", "反", "欠", "親", "転", "険", "頼", "偽", "層", "弱", "格", "溢", "線", "被", "造", "台", "奨", "宛", "岐", "年", "推", "旧", "昧", "曖", "業", "混", "満", "究", "緩", "避", "静", "飾", "刷", "増", "宣", "承", "曜", "段", "編", "越", "逆", "道", "障", "乗", "充", "地", "均", "排", "桁", "永", "累", "途", "階", "々", "世", "乱", "任", "六", "冗", "助", "収", "塊", "山", "弧", "従", "循", "憶", "括", "損", "放", "普", "曲", "減", "率", "登", "真", "社", "節", "米", "継", "荷", "診", "論", "近", "速", "隠", "久", "働", "判", "則", "原", "厳", "因", "図", "学", "工", "布", "抜", "抽", "施", "早", "望", "朝", "水", "海", "深", "湾", "監", "稼", "積", "突", "箇", "築", "系", "純", "良", "落", "術", "討", "質", "遣", "韓", "須", "鮮", "交", "仏", "像", "典", "写", "向", "土", "尽", "差", "巻", "市", "役", "折", "揃", "潜", "然", "片", "糊", "紀", "給", "群", "翻", "著", "衆", "訳", "貫", "資", "隔", "首", "香", "験", "三", "事", "伝", "伴", "凍", "占", "垂", "央", "妥", "寄", "岡", "川", "底", "念", "急", "掃", "搬", "教", "昇", "案", "植", "横", "歪", "洋", "派", "添", "港", "版", "独", "狭", "界", "礁", "福", "簡", "粋"},
+		"nl":      {"e", "n", "a", "t", "i", "r", "o", "s", "d", "l", "g", "v", "k", "m", "p", "u", "b", "c", "h", "w", "f", "j", "z", "y", "x", "ë", "é", "q", "ï", "ó", "ä", "ö", "ā", "á", "š", "č", "í", "ē", "ī", "ı"},
+		"pl":      {"a", "i", "e", "o", "n", "z", "w", "s", "r", "t", "y", "k", "p", "c", "d", "u", "l", "m", "j", "g", "b", "ł", "ż", "h", "ą", "ę", "ó", "ś", "ć", "f", "ń", "ź", "v", "x", "q", "é", "ɔ", "ş", "ă", "ţ"},
+		"pt":      {"a", "e", "o", "r", "i", "s", "d", "n", "t", "c", "m", "p", "l", "u", "f", "v", "ã", "h", "b", "g", "ç", "á", "q", "x", "z", "í", "é", "ó", "j", "õ", "ú", "k", "ê", "y", "w", "â", "à", "ô", "º", "å"},
+		"ro":      {"e", "i", "a", "r", "t", "n", "u", "c", "l", "s", "o", "d", "p", "ă", "m", "f", "b", "v", "z", "g", "ț", "ș", "h", "î", "x", "â", "k", "j", "w", "ţ", "y", "ş", "q", "å", "ç", "ð", "đ", "ı", "ū", "ʻ"},
+		"ru":      {"е", "о", "а", "и", "н", "т", "р", "с", "л", "в", "к", "п", "д", "м", "у", "я", "з", "ы", "ь", "й", "б", "ч", "г", "ж", "ф", "ю", "ш", "ц", "e", "х", "t", "i", "a", "s", "щ", "r", "o", "l", "p", "n"},
+		"sk":      {"a", "o", "e", "n", "i", "r", "s", "t", "v", "p", "k", "l", "u", "d", "z", "á", "m", "b", "y", "c", "h", "j", "í", "č", "ý", "é", "ž", "ú", "ť", "š", "ľ", "g", "f", "x", "ô", "ó", "w", "ň", "ä", "ď"},
+		"sl":      {"a", "e", "i", "n", "o", "r", "t", "s", "k", "v", "p", "l", "j", "d", "z", "m", "u", "b", "č", "g", "š", "c", "h", "ž", "f", "y", "x", "w", "q", "ö", "á", "å", "ç", "ą", "đ", "ū"},
+		"sr-Cyrl": {"а", "е", "и", "о", "н", "р", "с", "т", "к", "у", "п", "д", "в", "м", "ј", "з", "л", "б", "г", "ш", "њ", "ч", "ц", "љ", "e", "t", "ж", "a", "b", "s", "х", "ћ", "i", "o", "r", "n", "l", "ф", "c", "p"},
+		"sr-Latn": {"a", "i", "n", "e", "k", "r", "o", "s", "t", "l", "j", "u", "m", "d", "v", "b", "p", "g", "z", "š", "č", "h", "c", "f", "ž", "ć", "đ", "y"},
+		"tr":      {"a", "i", "e", "l", "r", "n", "ı", "d", "k", "m", "t", "s", "y", "u", "o", "b", "ş", "z", "ç", "g", "ü", "c", "p", "ğ", "h", "v", "ö", "f", "w", "x", "j", "q", "â", "á", "é", "í", "ë", "è", "ŋ", "å"},
+		"uk":      {"а", "н", "о", "и", "е", "р", "і", "в", "т", "к", "с", "д", "л", "п", "м", "у", "з", "я", "б", "ь", "г", "й", "ч", "ж", "х", "ц", "є", "ф", "ш", "ю", "e", "s", "t", "i", "a", "r", "ї", "l", "o", "n"},
+		"vi":      {"n", "h", "t", "c", "i", "g", "a", "đ", "u", "o", "m", "k", "l", "p", "r", "b", "s", "d", "y", "á", "ô", "ư", "à", "e", "v", "ế", "ể", "ạ", "ệ", "ố", "ợ", "ộ", "ê", "ị", "ả", "ó", "ậ", "ầ", "ớ", "x"},
+		"zh":      {"的", "用", "不", "法", "在", "有", "件", "定", "一", "使", "出", "行", "文", "中", "无", "字", "名", "式", "無", "指", "个", "目", "是", "和", "檔", "符", "語", "取", "入", "要", "效", "能", "到", "数", "列", "以", "提", "案", "为", "作", "未", "可", "引", "或", "已", "语", "個", "分", "新", "时", "示", "數", "除", "存", "本", "交", "支", "选", "令", "包", "置", "被", "失", "格", "程", "正", "如", "為", "加", "位", "更", "前", "重", "時", "選", "模", "合", "项", "输", "設", "建", "果", "您", "对", "表", "命", "拉", "下", "了", "者", "值", "密", "項", "标", "所", "上", "輸", "需", "索", "多", "大", "错", "信", "斯", "解", "将", "号", "子", "同", "版", "空", "標", "非", "录", "于", "元", "进", "误", "设", "克", "钥", "配", "器", "制", "尔", "系", "成", "告", "區", "工", "號", "略", "序", "第", "鑰", "理", "錯", "并", "息", "此", "回", "任", "路", "过", "錄", "地", "期", "修", "將", "参", "共", "安", "只", "后", "而", "找", "立", "特", "其", "接", "改", "執", "於", "警", "显", "省", "没", "查", "部", "签", "動", "結", "金", "尼", "打", "量", "亞", "全", "得", "敗", "变", "變", "最", "里", "自", "後", "小", "每", "利", "认", "生", "顯", "证", "亚", "败", "誤", "沒", "過", "度", "組", "象", "之", "内", "区", "含", "相", "则", "移", "印", "開", "卡", "請", "物", "阿", "至", "等", "基", "代", "始", "容", "库", "止", "忽", "从", "對", "訊", "组", "读", "删", "请", "簽", "它", "比", "应", "資", "端", "西", "意", "创", "开", "必", "巴", "參", "證", "德", "次", "稱", "算", "持", "但", "据", "鍵", "当", "明", "型", "這", "寫", "讀", "现", "從", "知", "写", "完", "態", "服", "則", "庫", "动", "向", "來", "套", "态", "换", "國", "软", "间", "預", "执", "方", "务", "户", "限", "會", "通", "啟", "块", "操", "记", "狀", "状", "串", "布", "性", "整", "記", "外", "主", "国", "塊", "获", "送", "默", "退", "內", "统", "这", "会", "併", "称", "何", "来", "爾", "达", "键", "发", "進", "體", "刪", "束", "傳", "消", "允", "析", "马", "注", "因", "並", "日", "检", "及", "节", "该", "准", "面", "手", "保", "径", "料", "求", "間", "且", "关", "丁", "供", "址", "瓦", "缺", "函", "少", "份", "由", "类", "統", "準", "编", "义", "段", "發", "原", "徑", "码", "隔", "运", "仓", "排", "處", "跳", "识", "先", "編", "跟", "塔", "处", "機", "碼", "停", "罗", "否", "超", "類", "匹", "即", "启", "應", "按", "结", "源", "功", "替", "起", "纳", "援", "別", "太", "清", "真", "規", "關", "塞", "複", "链", "二", "追", "常", "與", "做", "古", "尾", "添", "与", "化", "复", "州", "伊", "别", "述", "返", "南", "载", "他", "科", "增", "檢", "義", "製", "突", "管", "补", "书", "导", "章", "連", "驗", "些", "助", "馬", "美", "许", "白", "然", "波", "兰", "普", "长", "也", "境", "给", "覆", "換", "遠", "域", "条", "裝", "远", "快", "须", "憑", "情", "描", "暫", "试", "转", "验", "單", "軟", "差", "公", "带", "群", "洛", "图", "當", "片", "英", "推", "羅", "都", "你", "根", "经", "偏", "萨", "把", "搜", "吉", "現", "确", "確", "級", "缓", "仅", "反", "好", "调", "维", "載", "装", "補", "踪", "尋", "留", "零", "依", "受", "希", "長", "經", "許", "附", "备", "蹤", "单", "形", "终", "说", "级", "身", "哥", "切", "待", "收", "米", "坏", "头", "想", "登", "冲", "尚", "计", "放", "线", "若", "島", "禁", "蘭", "规", "須", "圖", "埃", "牙", "缀", "言", "達", "哈", "試", "问", "母", "北", "道", "例", "納", "似", "假", "姆", "籤", "註", "缩", "再", "归", "我", "照", "說", "隆", "轉", "人", "維", "頭", "机", "三", "屬", "線", "計", "匯", "十", "择", "控", "條", "考", "識", "初", "該", "那", "展", "底", "属", "弃", "望", "具", "伯", "压", "奥", "托", "映", "衝", "伺", "岛", "连", "什", "史", "环"},
+	},
+	2: {
+		"ca":      {"a ", "s ", " e", " d", "e ", "es", "de", "t ", " a", "l ", "er", "r ", " s", " l", "re", " p", " c", "en", " n", "el", "no", "ar", "or", "n ", "ca", "o ", "nt", "al", "st", "it", "ci", "ra", "ta", "la", "te", " f", "in", "co", "at", "an", "on", "pe", " u", "i ", " i", "na", "ri", "le", " o", " h", "om", "si", "ec", "un", "tr", " m", "fi", "qu", "li", "ó ", "se", " r", "ió", "da", "d ", " t", "me", "ue", "ti", "po", "ct", "ha", "is", "ro", "pr", "ic", "et", "ad", "ma", "ac", "gu", "am", "ia", "di", "ll", "u ", "ut", "és", "ls", "xe", "nc", "os", " v", "id", "pa", "ns", "ni", " b", "mi", "eg", "to", "mp", "ts", "em", "ix", "ex", "ir", "ss", "mb", "tx", "x ", "gi", "ei", "ot", "ur", " g", "lo", "im", "bl", "io", "rs", "sp", "us", "m ", "mo", "va", "ob", "eu", "fe", " q", "ne", "su", "rt", "b ", "rr", "sa", "ig", " é", "br", "ua", "ba", "op", "nd", "fo", "rm", "tu", "ap", "ab", "aq", "so"},
+		"cs":      {" p", "e ", " n", " s", "í ", "o ", "a ", " v", "po", "ov", "na", "st", "ní", "u ", "ne", "en", " z", "t ", "ro", "ou", " k", " j", "y ", "pr", " a", "je", "é ", "př", "te", "or", "at", "no", "ch", "od", " o", "lo", "ře", "i ", " d", "ko", "el", "ý ", "án", "ta", "ra", "sk", "se", "so", " b", "ze", "in", "m ", "li", "va", "bo", "ná", "to", "al", "ti", "ka", " c", "it", "er", "es", " m", "ho", "ak", "vy", "vá", "le", "ve", "á ", "n ", "an", "tu", "za", " t", "ep", "ce", "la", "ný", "ad", "ar", "re", "do", "dn", "né", "az", "ub", "vý", "ed", "k ", "s ", " u", "os", "de", "ké", "ol", "kl", "če", "vo", "d ", "l ", "zn", " r", "on", "is", "uj", "áv", "h ", "nt", "as", "da", "ří", "ů ", "ru", "me", "r ", "sl", " i", "lí", "av", "tn", "už", "ač", "ku", " l", "v ", "ez", "am", "et", "ba", "ac", "eb", "ži", "ob", "ři", "ic", "ká", "up", " č", "ot", "em", "rá", "pí", "pi", "ma", "pl", "cí", "yb"},
+		"de":      {"n ", "en", "er", "e ", "ch", "t ", "ei", "te", "de", " d", "r ", " a", "in", "ge", " s", "ie", "s ", "be", "re", "st", "un", "es", " e", "ic", "an", "is", "nd", "ng", "sc", " n", "le", "it", "ne", " b", "on", " i", "at", "ti", "ni", " w", "he", " k", "se", " v", "nt", "ze", " f", "h ", "au", " u", "el", "we", "al", "si", " g", "d ", " z", "da", "ar", " m", "ht", "rd", "di", "rt", "ig", "hl", "or", "ve", "li", "fe", " p", "et", "us", "nn", "me", "m ", "g ", "ra", "l ", "lt", " o", "ll", "ta", "ss", "zu", "as", "ke", "eh", "ur", "mi", "na", "ri", "ab", "vo", "ko", "rs", " l", " r", "fü", "ma", "io", "i ", "la", "hr", "nu", "uf", "um", "ka", "ru", "eb", " t", "tz", "pa", "ha", "em", " h", "kt", "am", "wi", "pr", " c", "ns", "im", "mm", "eg", "om", "gi", "ac", "ür", "il", "a ", "ak", "ts", "ef", "sp", "ol", "od", "ck", "ut", "op", "nz", "rz", "f ", "pe", "tu", "ir", "ek", "rn", "tr", "fo"},
+		"en":      {"e ", "t ", "in", "s ", "d ", "n ", "re", " t", " s", " a", " i", "er", "on", "an", "te", "or", " c", " o", "r ", " f", "ti", "th", "at", "ed", "le", " n", "ng", "se", "es", "o ", " r", "al", "st", "no", " b", "en", "he", "ar", "is", "ec", " d", "it", "io", "g ", " p", " m", "to", "y ", " e", "a ", "co", "nd", "li", "nt", "ct", " u", "ot", "de", " w", "ra", "l ", " l", "ma", "fi", "il", "me", "ca", "h ", "ch", "ou", "si", "un", "ta", "na", "ro", "ne", "pe", "ri", "ge", "us", "fo", "as", "f ", "lo", "ns", "ut", "di", "ea", "la", "ha", "ve", "el", "ad", " g", "om", "et", "tr", "ex", "ac", "of", "pa", "ss", "va", "am", "be", "ic", "op", "pr", "id", "ce", "ll", "ab", " h", "hi", "ur", "bl", "mo", "ul", "ol", "em", "rt", "mi", "c ", "wi", "ni", "gi", "ai", "po", "ig", "oc", "ag", " k", "m ", " v", "sh", "i ", "ia", "nc", "mb", "ir", "su", "up", "pt", "if", "ow", "ke", "rn", "k ", "rr", "ba"},
+		"es":      {"o ", "e ", "a ", "de", " d", " e", "s ", "n ", "es", "en", " s", "ar", "er", " c", "r ", "re", "ra", " p", "l ", "do", " a", "ci", " l", " n", "no", "co", "se", "la", "in", "or", "te", "ad", "el", "nt", "al", "on", "ta", "st", "os", "ca", " r", "ec", "to", "ic", "ro", "ón", " i", "ue", "ió", "tr", " u", "da", "li", " f", "as", "lo", "ti", "an", "ac", "pa", "id", "si", "ma", " o", "un", " m", "na", "ne", "io", " t", "ri", "di", "fi", "it", "le", "nd", "om", "mi", "po", "pe", "me", "is", "qu", "am", "ct", "ce", "ia", "ch", "pu", "ed", "ie", "nc", "pr", "ir", "sa", "mb", " v", "et", "so", "t ", "op", "mo", "ab", "bi", " b", "ea", "iv", "at", "sp", "cc", "mp", "bl", "em", "us", "ni", "ve", " g", "gi", "va", "ol", " h", "eg", "vo", "he", "oc", "rm", "rr", "ns", "im", "gu", "za", "ig", "rt", "sc", "y ", "ut", "br", "su", "ll", "ha", " q", "ua", "ex", "il", "if", "pl", "d ", "tu", "cr", "rc"},
+		"fr":      {"e ", "s ", " d", "de", " l", "es", "le", "r ", "on", "t ", "n ", "re", "er", " p", "ti", " s", " a", " c", "en", "an", "nt", " e", "te", "ur", "in", " i", "io", "a ", "ou", " n", "is", "ch", "co", "li", "la", "st", "at", "ne", "pa", " r", "l ", "tr", "se", "po", " u", "al", " m", "ar", "u ", "or", "fi", "qu", "ie", "ns", "ue", "it", "si", "ra", "me", " t", "ut", " o", "un", "ec", " f", "d ", "ré", "ma", "eu", "ct", "ss", "nd", "ve", "as", "ri", "om", "ta", "et", "ic", "no", "il", " v", "ai", "ce", " b", "é ", "bl", "au", "ir", "he", "em", "i ", "ro", "da", "mp", "pr", "sa", "nc", "pe", "im", "ée", "rt", "va", "dé", "su", "éc", "du", "hi", "ca", "ér", "di", "na", "ge", "us", "ac", " g", "op", "rs", "ig", "ni", "mi", "so", " é", "c ", "rr", "ib", "to", "id", "oi", "mo", "ag", "if", "el", "ex", "ll", "à ", "ha", "gn", "lo", "os", "mm", "ts", " à", "ng", "ui", "nn", "av", "tt", "iq", "o "},
+		"gl":      {"o ", "a ", "e ", " d", "s ", "n ", "de", " a", " s", "ar", "es", " c", "te", " p", "no", " n", " e", "do", "on", "re", "in", "ra", "r ", "er", "co", "en", "an", "or", "al", "ci", "os", " o", "ca", "ta", "se", "ic", "st", "nt", "as", "ro", "ma", "ec", "li", " m", "ad", " i", "ri", "l ", "da", "ón", " f", "po", "pa", "la", "to", "ti", "ac", " t", " u", "un", " r", "ió", "si", " l", "na", "qu", "id", "io", "nd", "ir", "me", "el", "is", "ia", "le", "lo", "tr", "di", "ch", "fi", "om", "ue", "ha", " b", "at", "be", "pr", "gu", "em", "ct", "it", "ng", "us", "pe", "et", "ni", "ua", "su", "ur", "ei", "mo", "rt", "sa", "ce", "am", "ns", "ll", "u ", "ve", "so", "he", "i ", "ou", "ga", "mi", " v", "nc", "ol", "bl", "ex", "ba", "ig", "ab", "ai", "rr", "tu", "va", "és", " g", " q", "ep", "za", "mp", "xe", "fo", "od", "ui", "ut", "cr", "il", "op", "ne", "sc", "é ", "sp", "iv", " h", "oc", " é", "ap"},
+		"hr":      {"a ", "e ", "i ", "je", " s", " p", " n", "na", "ij", "ni", "re", " i", "ra", "an", "o ", "st", "ri", "po", "u ", "ko", "ka", " d", "en", "ti", " o", "pr", "sk", " u", "ja", " z", "ne", "ki", "ta", "to", "at", "da", "or", "ar", "za", "ak", "no", " k", "va", "li", "ci", "in", "te", "ma", " a", "ek", "is", "ed", " j", "nj", "al", " r", " b", "av", "ov", " m", "la", "im", "me", "ro", "om", " t", "ot", "ir", "m ", "lj", "os", "ve", "od", "it", "s ", "er", "mo", "on", "sp", "vi", " v", "az", "n ", "ik", "og", "iz", "op", "et", "tr", "se", "t ", "zn", "ke", "us", "il", "lo", "k ", "em", "d ", "ad", "di", "sa", "oj", "ju", "es", "gu", "dn", "ic", "vr", "pi", "aj", "si", "am", "el", "nt", "nu", "zi", "ol", "do", "ev", " l", "j ", "de", "ba", "či", "ez", "bi", "ns", "su", " f", "pa", "br", " g", "z ", "ič", "gr", "vo", "up", "g ", " c", "r ", "h ", "ok", "ab", "pc", "iv", "um", "un", "ac", "ap"},
+		"hu":      {"a ", " a", "t ", " k", "el", "sz", "s ", "k ", "en", "et", "le", " m", " s", "n ", "me", "te", "l ", " n", "ás", "i ", "ál", "z ", "és", " h", " f", "at", " e", "e ", "ne", "cs", "eg", "er", "ha", "tá", " t", "gy", "ra", "in", "an", "al", "so", "ar", "ol", "re", "or", "ez", " b", " v", "sa", "ít", "ka", "m ", "az", "ny", "em", "ak", " l", "nt", "ta", "va", "es", "as", "la", "ki", "ér", "ke", "rt", "ek", "lt", "ll", " p", "ó ", " é", "té", "r ", "to", "tt", "y ", "be", "ze", "ve", "is", "ma", "ok", " c", "on", "ap", "se", "ag", "ár", "os", "nc", "ik", "ye", "én", "g ", "oz", "kö", "zá", "li", "he", "áj", "nd", "ná", "án", "lá", " i", "ad", "je", "ni", "ti", "zi", "zt", "na", "ss", "jl", "ló", "ot", "fá", "vé", "za", "lé", "hi", "ho", "mi", "vá", "ko", "il", "si", "ba", "fe", "pa", "ő ", "zn", "rá", "sé", "fo", "om", "tó", "ro", "má", " r", "de", "um", "ég", "sá", "ul", "tu", "ri", "ga"},
+		"id":      {"an", "n ", "da", " d", "ka", "ng", "a ", "i ", "k ", "er", " t", "en", "ak", "at", "t ", " m", " p", " s", "ti", " b", "ar", "al", "ta", "me", "la", " k", "ga", "un", "ba", "di", "pe", "in", " a", "si", "as", "ri", "s ", "pa", "g ", "id", "te", "ik", "l ", "se", "nt", "h ", "ap", "ra", "ah", "uk", "na", "re", "it", "ma", "li", "am", "tu", "em", "be", "ya", "ke", "sa", "e ", "r ", "ha", " u", " g", "el", "is", "nd", " i", " r", "de", "ua", "bu", "ek", "ad", "on", "mb", " l", "u ", "ai", "ko", "or", " c", "gi", "gu", "il", "ia", "et", "lu", "le", "ca", "rk", " y", "ru", "ny", "ag", "m ", "es", " n", " o", "ni", "ur", "ja", "ab", " h", "ul", "eb", " j", "om", "d ", "st", "su", "to", "ol", "ut", "mi", "mo", "ih", "ku", "pu", "us", "ks", "bi", "ip", "gg", "gk", "po", "ir", "ep", "rl", "ub", "mu", "im", "lo", "mp", "au", "pi", " v", "kt", "fi", "ot", "um", " f", "je", "ac", "du", "os", " e"},
+		"it":      {"e ", "o ", "a ", "i ", "on", " d", "re", " s", " i", "er", " c", "n ", "to", "l ", "ri", "ta", "di", "le", "co", " a", "in", " n", "no", "at", "il", "al", " p", "te", "or", "en", "nt", "es", "de", "ne", "io", " l", "ti", "st", "li", "ar", " r", "ra", "el", "se", "an", "it", " e", "ic", "si", "ca", "me", "la", "po", " u", "ll", "ss", "fi", "un", " m", "ch", "ro", "zi", "im", "tt", "ma", "pe", "na", " f", "om", "t ", "so", " o", "os", "ia", " t", "lo", "is", "bi", "gi", "tr", "ci", " v", "mp", "nd", "r ", "ni", "ut", "ve", "et", "sc", "da", " g", "ec", "eg", "sa", "pa", "va", "us", "ce", "ol", "pr", "he", "mo", "am", "su", " b", "as", "mi", "ir", "do", "ib", "hi", "az", "if", "id", "è ", " è", "ag", "gu", "sp", "ng", "vi", "gg", "ot", "d ", "ge", "op", "ur", "ac", "rr", "za", "oc", "av", "nc", "iu", "ie", "ig", "cc", "iz", "ui", "em", "cr", " h", "pu", "rm", "ue", "ua", "od", "rt", "qu"},
+		"nl":      {"n ", "en", "e ", "t ", "er", "ge", "an", "de", "s ", "te", " v", "aa", "in", " d", " o", "st", "et", "ie", "ee", "el", " a", "re", "nd", "r ", " g", " i", " b", "ar", " t", "ta", " e", "al", "es", "d ", "on", "ve", " s", "or", "is", " n", "le", "ke", "ch", "be", "rd", " m", "ti", "ij", "ng", "he", "op", "va", "ui", " h", "l ", "vo", "oe", "oo", "me", "ma", "at", "ni", "nt", " w", "it", "eg", "li", "di", " p", "ro", "sc", "na", "g ", "ra", "to", "ek", " k", "ne", "ns", "ig", "k ", "ri", "se", " r", "ev", "br", " c", "ak", "la", "ll", " l", "om", "pa", "p ", "eb", "rs", "ls", "m ", " z", "da", "pe", "ei", "ru", " u", "co", "wa", "ld", "am", "a ", "ik", "ac", "f ", "ol", "pt", "ts", "id", "si", "ka", "do", "wo", "we", "pr", "h ", "ap", "bi", "ht", "em", "ze", "ha", "ec", "tr", "ep", "zi", "rt", " f", "ou", "sl", "of", "ko", "wi", "ba", "ed", "ut", "lo", "ss", "um", "rg", "as", "gr", "hi"},
+		"pl":      {"ie", "ni", "e ", "a ", " p", " n", "i ", "an", " w", "o ", "na", " z", "st", "y ", " s", "ow", "po", "wa", "cz", " d", "za", "en", "li", "u ", "wy", "ra", " o", "ia", "ze", "od", "ta", "pr", "ki", "rz", " k", " u", "ko", " m", "zy", "w ", "wi", "t ", "ny", "ka", "ne", "er", "ć ", "ch", " b", "al", "je", " t", "do", "on", "ro", "ik", " a", " j", "mi", "ar", "es", "sk", "or", "ci", "is", "ak", "mo", " i", "z ", "to", "cj", "re", "pi", "aw", " r", "go", "da", "ty", "in", "sz", "no", "ma", "at", "m ", "le", "h ", "ek", "ac", "ów", "j ", "yc", "eg", "te", " l", " c", "ej", "zn", "k ", "we", "ce", " g", "la", "as", "op", "ię", "oż", "os", "pl", "ym", "ja", "am", "żn", "su", "ol", "lo", "ad", "d ", "dn", "śc", "lu", "us", "em", "yt", "tr", "si", "om", "ę ", "pa", "io", "dz", "ło", "ał", "nt", "ec", "el", "ob", "zo", "ku", "az", "oz", "ic", "aj", "zw", "tu", "ąc", "se", "ji", "zi", "ą ", "n "},
+		"pt":      {"o ", "a ", "e ", " d", "s ", "de", " a", " e", "ar", "r ", " p", "es", "do", "ra", " s", " c", "er", "in", "re", "te", "co", "ão", "ad", " n", "os", "or", "m ", " o", "en", "nt", "ta", "pa", "da", " i", "al", " f", "ca", "ma", "as", "li", "st", "em", "ri", "me", "se", "ic", "om", "po", "ro", " u", "on", "to", " r", " m", "an", " t", "fi", "ec", "ve", "ti", "is", "um", "l ", "çã", "id", "no", "ir", "nd", "qu", "tr", " l", "na", "mo", "ia", "it", "el", "ci", "lo", "pe", "pr", "im", "ss", "sa", "at", "di", "ei", "am", "aç", "io", " v", "nã", " b", "fo", "so", "he", "nc", "ch", "nh", "ha", "ex", "si", "mp", "la", "us", "vo", "va", "sp", "et", "oc", "ce", "ui", "ne", "u ", "iv", "ap", "ou", "ac", "ni", "t ", "ue", "su", "ho", " g", " q", "op", "gu", "le", "rr", "mi", "ua", "fa", "é ", "ef", "lh", "rm", " é", "ig", "rt", "tu", "od", "sc", "ut", "il", "lt", "ol", "ct", "ur", "if", "ai", "õe"},
+		"ro":      {"e ", "re", "ă ", "a ", " s", " d", "te", "ar", " a", " c", "i ", "de", "er", " p", "at", "t ", " n", "in", "u ", "st", "ea", "nt", " e", "l ", "un", "ul", "al", "ne", "es", "ri", "en", " f", "li", "ta", "tr", "ți", "ti", " i", "or", "nu", "ie", "ca", "ec", "le", "n ", "se", "fi", "ru", " l", "me", "ic", "r ", "il", "pe", "ce", "ra", "tă", " r", "el", " o", " u", "cu", "im", " m", "it", "co", "ut", "ni", " î", "ac", "ma", "oa", "an", "în", "și", " t", "lu", "lo", "pr", "iu", "la", "si", "di", "ro", " b", "ur", "ct", "ză", "on", "po", "ia", "is", "va", "tu", "um", "ui", "oc", "ir", "d ", "to", "ci", "ch", "nd", "et", " v", "na", "că", "az", "sa", "su", "op", "ve", "s ", "ră", "he", "o ", "da", "om", "ăr", "id", "bi", "rt", "sc", "ex", "ep", "mp", "pa", "mi", "iș", "as", "b ", "pu", "nc", "au", "ei", "nă", "em", "iz", "ol", " g", "za", "eș", "os", "aț", "fo", "rm", "if", "cr", "să", "c "},
+		"ru":      {"е ", " п", "я ", " н", " с", "ен", "не", "а ", "ст", " в", "ни", "и ", "ра", "ре", "по", "ь ", " и", "но", "ер", "о ", "ов", "й ", "ан", "ка", " о", "ть", "ол", "ет", "ат", "на", " к", "пр", " д", "ме", "ро", "ко", "ва", "ль", "ны", "то", "ис", "да", "в ", "ит", "т ", "та", "тр", "де", "од", " р", "ие", "им", "ли", "за", "ло", "те", "ти", "во", " у", "ос", "ве", "ал", "ес", "ор", " з", "ом", "ел", "ия", "аз", "ле", "м ", "ск", "об", "ы ", "тс", "ля", "ри", "ин", "пе", "от", "вы", "нн", " б", "ем", "ек", "ый", "ед", "си", "ар", "ла", "мо", "ай", "ки", "ся", "сп", "до", " ф", "со", "оп", "па", " т", "ав", "ма", "ог", "ая", "сл", "ок", "че", "го", "ци", "дл", " а", "он", "ир", "ой", "с ", "н ", "ам", " м", "ру", "к ", "ий", "ак", "р ", "ае", "ож", "ьз", "зо", "х ", "из", "ши", "нд", "оз", "фа", "ви", "нт", "ще", "ди", "у ", "тв", "уд", "йл", "ик", "жи", "ус", "ев", "ьн", "тн", "же", "ас"},
+		"sk":      {"a ", "e ", " p", " s", " n", "ov", "na", " v", "o ", "pr", "po", "ne", "re", " a", "ie", "en", "st", " z", "ni", "u ", "an", "or", "va", "é ", "al", "ť ", "ko", "ý ", "je", "in", "y ", "sk", "to", "sa", "ta", " k", "ro", "á ", " j", "né", "ra", "od", "ka", "ný", "ri", " b", "ná", "bo", "lo", "ia", "te", " m", " o", "ad", " r", "at", "er", "ti", "ve", "i ", "ch", "ak", "ba", "no", "v ", "la", "li", " d", " t", "vy", "m ", "ho", "ar", "vo", "ed", "me", "ep", "az", "ci", "os", " c", "on", "zo", "es", "le", "ol", "r ", "či", "k ", "tu", " i", "ík", "am", "sú", "as", "za", "mo", "tr", "da", "ož", "om", "ať", "ká", "iť", "do", "is", "dn", "ou", "už", "t ", "ot", "de", "áv", " u", "nt", "rá", "av", "uj", "zn", "í ", "úb", " h", "s ", "eb", "ži", "tn", "up", "it", "ky", "et", "vý", "ob", "sl", "žn", "pl", "ru", "l ", "oz", "aj", "rí", "h ", "ik", "ár", "ma", " f", "d ", "sp", "ča", "št", "z "},
+		"sl":      {"a ", "e ", "i ", "na", " n", " p", "o ", "ni", " s", "ra", "ka", " i", "st", "je", "en", "an", "pr", "po", "re", "te", " z", " v", "ne", "ti", "in", " d", "no", "ko", "ve", "iz", "me", "av", "to", "za", "od", "at", " o", "da", "ri", "ja", " k", "n ", "sk", "ev", "nj", "ta", "or", "ak", " m", " u", "ov", "li", "v ", "lo", "ot", "ar", "va", "lj", "mo", "al", "ed", " t", "il", "ik", "el", "t ", "se", "it", " a", "os", "vi", "či", "če", " b", "is", " j", "em", "ek", "pi", "ke", "la", "ki", "bi", "aj", "im", "am", "oč", " r", "et", "k ", "ma", "do", "az", "z ", "ol", "pa", "ir", "er", "m ", " l", "on", "dn", "le", "vn", "de", "up", "og", "go", "sa", "zn", "es", "ga", "s ", "h ", "ez", "ij", "ic", "ap", "šč", "ob", "d ", "ro", "as", "tr", "ab", "om", "vr", "sp", "u ", "vo", "eg", " č", "si", "ad", "r ", " š", "us", "ip", "l ", "di", "ji", "rs", "ih", "op", "zb", "ej", "ič", "ča", "iv", "nt"},
+		"sr-Cyrl": {"а ", "е ", "и ", " п", " н", "ре", " с", "на", "ра", " д", "ст", "о ", "је", " и", "пр", "у ", "ка", "та", "по", "ни", " о", "ис", "не", "да", "ва", " у", "но", "м ", "ко", "ан", "те", "ен", "ав", " к", "ор", "ри", "ат", "од", "за", "иј", "то", "ар", " з", "ме", "ед", "ак", "ла", "са", "ли", "ос", "ек", "им", "ве", "ск", "ма", "ти", " б", "аз", " м", "ов", "ањ", " р", "ин", "ем", " а", "ње", "де", " ј", " в", "ај", "сп", "ро", "из", "н ", "от", "мо", "ив", "ам", "ки", "ом", "ог", "ја", "ци", "ит", "ик", "ви", " т", "во", "до", "ња", "еш", "ал", "си", "ер", "тр", "ке", "ој", "оп", "се", "к ", "гр", "пи", " г", "ад", "вр", "зн", "ел", "зи", "уј", "ди", "он", "ло", "уп", "чи", "т ", "би", "ил", "ба", "ум", "гу", "др", "ку", "ет", "оз", "ир", " b", "дн", "шк", "ич", "ењ", "ес", "ас", "су", "ез", "b ", "д ", "ну", "ју", "ру", "г ", "ок", "шт", "бе", "ле", "ол", "ј ", "об", "бр", "нс", "нт"},
+		"sr-Latn": {"a ", "i ", "an", "ki", "sk", "ka", " k", "ra", " s", "ar", "ja", " p", "o ", "na", "li", "e ", "ta", "al", "n ", "in", "ij", "va", "re", "je", "ri", "en", " b", " m", "ma", "ni", "st", " a", "la", " n", "er", "on", " d", "ns", " r", "ko", "ik", "or", " i", "aj", "ak", "r ", "ve", "at", "am", "ba", " l", "pa", " t", " j", "is", "sa", "nj", "to", "av", "no", "nd", "te", "ti", " o", "ng", " g", "bl", "et", "pu", "ne", "šk", "ub", "mo", "ro", "da", " v", "vi", "ep", "t ", "ur", "ad", "ga", "ir", "os", "tr", " h", "el", "ol", "zi", "ov", "za", "di", "nt", "as", "ke", "u ", "le", "po", "ci", "se", "ez", "do", "me", " f", "be", "l ", "lo", "d ", "de", "s ", " z", "ev", "si", "bu", " e", "kr", "go", "it", "ed", "rs", "il", "k ", "ok", "ot", "m ", "pi", "ju", "ru", "ic", "im", "em", "pr", "es", "un", "lj", "ek", "om", "j ", "js", " u", "ej", "dž", "mi", " č", "ha", "ab", "ša", "ku", "ag", "bi"},
+		"tr":      {"n ", "r ", "i ", "la", " b", "le", "er", "a ", "ar", "ı ", " d", "e ", "an", "in", " i", "en", " s", " a", "ma", "ir", " k", "ya", "de", "il", "k ", " g", "al", " y", "li", "ri", "bi", "nı", "nd", "ne", "ta", "me", "am", "di", "si", "ek", "ın", "ak", "ay", "ra", "ır", "rı", "t ", "da", "em", "ti", "dı", "sı", "lı", "ol", "ni", "te", "iş", "yo", " o", "ul", "or", "el", "u ", "ıl", "ge", " t", "iz", " e", "re", "at", "iç", "z ", "sa", "ad", " v", "na", "ll", "çe", "l ", "et", "çi", "es", "ka", "ve", "kl", " h", "ye", "st", "ik", "ba", "se", "as", "ki", "eğ", "rl", "eç", "mi", "m ", "do", "ku", "ği", "ey", " ç", "tı", "un", "im", "şl", "gi", "on", "is", " p", "ur", "az", "os", "ış", "it", "şt", "ha", "ru", "lm", "iy", " n", "sy", "rd", "lu", "aş", " u", "ş ", "ml", "nu", "ok", "pa", "rs", "ke", "be", "kt", "rm", "ko", "ca", "mı", "yı", " m", "ld", " ö", "ed", "bu", "ap", "nm", "nl", "ça"},
+		"uk":      {"а ", " п", "я ", "ан", "и ", " в", "о ", " н", "ст", "ти", "на", "ка", "не", "ен", "у ", "ко", " з", "е ", "но", "ре", " с", "ор", "ва", " д", "нн", "по", "ви", "ер", "ов", "і ", "ня", " к", "ро", "ра", "ри", "ат", "та", "за", "ом", " р", "ід", "ни", " м", "ал", "й ", "ма", "тр", "ис", "им", "до", "пе", "пр", "ві", "ар", " б", "ів", "ув", "ий", " а", "да", " о", "ні", "ек", "ло", "во", "то", "м ", "ьк", "ла", " т", "ам", "в ", "ик", "ос", " і", "ін", " у", "ай", "аз", "он", "ми", "лі", "го", "ме", "мо", "пі", "ит", "оз", "ог", "мі", "ль", "рі", "сь", "є ", "ля", "од", "іс", "па", "ол", "ве", "об", "іл", " ф", "ач", "ь ", "зн", "ді", "ав", "ес", "си", "ть", "ся", "ил", "ці", "че", "з ", "ле", "оп", "нд", "те", "бу", "ки", "р ", "ас", "де", "ру", "нт", "ли", "ок", "х ", "к ", "д ", " я", "су", "ту", "ку", "ї ", "ну", "ед", "ба", "ап", "дл", "кт", "ив", "тн", "ак", "аб", "вн", "н ", "л "},
+		"vi":      {" t", "ng", " c", "g ", "n ", "i ", " đ", "c ", " k", "ch", "th", " l", " n", "nh", "t ", "kh", "h ", " b", "a ", " h", " m", "u ", " g", "o ", "ôn", " d", "p ", " v", " s", "y ", "hô", "tr", "hi", "ti", "m ", " p", "ác", "ph", "in", "ho", "gi", "iế", " r", "cá", "ể ", "à ", "ên", "ượ", "ến", "iệ", "ập", "đư", "ha", "hư", " x", "ó ", "on", "an", "ị ", "hể", "ợc", "e ", "tậ", "ần", "có", "hu", "là", "và", "uy", "ỗi", "ới", "ục", "ố ", "ột", "ạn", "ối", "ro", "ra", "ản", " q", "ùn", "iể", "đị", "ại", "ện", "số", "ển", "qu", "mộ", "ết", "lệ", "ã ", "há", "lỗ", "ịn", "it", "củ", "ủa", "hỉ", "ý ", "ay", "dù", "sa", "iê", "ỉ ", "vớ", "ệ ", " a", "mụ", "ỏ ", "tạ", "àn", "he", "li", "ọn", "un", "ư ", "họ", "ào", "re", "đã", "án", "ải", "ặc", "bi", "ếu", "ia", "đố", "vi", "ộn", "òn", "ao", "d ", "ầu", "ườ", "hậ", "gh", "ai", "tê", "ký", "lạ", "đầ", "ất", "ự ", "yể", "ặp", " i", "ộ ", "ki", "bỏ"},
+	},
+	4: {
+		"ca":      {" de ", " no ", " el ", " la ", " per", " ha ", "ció ", "per ", "s de", "s ha", " com", " s h", " en ", " un ", " est", "les ", " fit", "fitx", "itxe", "txer", "a de", "els ", "no s", "de l", " nom", "ent ", " con", " del", "ment", " es ", " és ", "o s ", "er a", "ació", "ada ", "xer ", " els", "gut ", " amb", " des", "aque", "amb ", "r a ", "ha p", " les", "eix ", "una ", " una", "del ", "es p", "es d", "sió ", "t de", "no e", "de c", "ida ", "ogut", " que", " pog", "pogu", "esta", "que ", "a po", "r de", " esp", "ons ", "e de", "o es", "ió d", "està", " pro", "espe", "s es", "s po", "cion", " git", "git ", "tat ", " opc", "a el", "opci", "ions", "r el", "ó de", "quet", "tori", "orma", " a l", "el f", " l e", "form", " pot", "a la", "de s", "stra", "des ", "ostr", "pot ", "a co", "e co", "ant ", "l fi", "tes ", "fica", "stà ", "ecte", "ific", " s e", "rect", "ers ", "litz", "itza", "comp", "a un", "tra ", "més ", "e l ", "miss", "paqu", " for", "ques", "vàli", "àlid", "er d", " si ", " can", " ord", "e la", "nom ", " dir", "ori ", "o és", " al ", "no é", " lín", " mos", "líni", "cte ", "most", "spec", "bre ", "ctor", " vàl", "ica ", " err", " l o", "a l ", "ecto", "erro", "dire", "irec", "ies ", " int", "ar e", " tro", " sen", " l a", "rdre", " seg", "la c", " ent", "scri", "res ", "ordr", "ar l", "anvi", "canv", "ènci", "cont", " d e", "el p", "de f", "entr", "peci", "ssió", " act", "a ca", "escr", " aqu", "rror", " cap", "nts ", "tual", "amen", "pció", "iona", "iste", "alit", "uest", "cifi", "ecif", " paq", "s d ", "el c", "nia ", "able", "tre ", "s en", "at d", "omis", "actu", "ctua", "comi", "en l", "xers", "lica", "issi", "s re", " rep", "com ", "ror ", "a es", "el s", "s co", "s no", "en e", "a d ", "re d", " esc", "nar ", " car", "sta ", "de d", "s pe", " cad", "anca", "de t", "ntra", "als ", "a re", "ble ", "ject", "prim", "trad", "ents", " cam", "e no", "dre ", "t un", " d a", " obj", " res", "bjec", "nomé", "obje", "omés", "ades", "conf", " exe", "ínia", "stat", "el n", "rada", " bra", " emp", "el d"},
+		"cs":      {" pro", "ení ", " sou", " pře", "soub", "oubo", "ubor", " je ", "ován", " na ", " se ", "ání ", "ské ", " pří", "uje ", " pou", "pro ", "klíč", " neb", " chy", " pod", "chyb", " klí", " při", "použ", "lze ", " nep", "stup", "vání", "přep", " nel", "elze", "nelz", "řepí", "plat", "epín", "pína", "stav", "ínač", "latn", "ebo ", "nebo", " vyp", "ní p", "přík", "ouži", " nen", " jak", "oru ", " zna", "ina ", "íkaz", "říka", "znak", "e po", "ého ", "bor ", " řád", "asta", " adr", "tina", "adre", "boru", "dres", "e ne", "není", "vat ", " sta", "ovat", "jako", "štin", "ých ", " kon", "ako ", "rová", "při ", "podp", "ový ", " zad", "epla", "nepl", "atel", "nast", "hodn", "ment", "líč ", "ní s", "resá", " nas", "áno ", "esář", "vate", "e př", " náz", "hyba", "yba ", " čís", "je p", "umen", "e se", "e vy", "užit", " arg", "řádk", " sel", "líče", "práv", "argu", "gume", "rgum", "cké ", "nost", "jící", "form", "ní v", "znam", " uži", "í po", "dnot", " vyt", "je v", "nač ", "sled", "vytv", "ace ", "ovan", "prav", "uživ", "čísl", "odno", " klá", "a př", "atný", "ivat", "kláv", "živa", "tný ", "aven", " do ", " výs", "láve", "áves", " pok", "ifik", "selh", "dní ", "ové ", "píše", "íše ", "sou ", "ověř", "ího ", "elha", " bal", " bud", "lhal", "tave", "vypí", "ící ", " jed", " poz", " poč", "balí", "e na", "e pr", "ické", "kaz ", "íče ", "je s", "ný p", " hod", " vst", "dová", "vstu", "tifi", "vých", " pol", "váno", "odpo", "poku", "jsou", "ypíš", "ření", " li ", "sti ", " pos", "dpis", "odpi", "výst", "ýstu", " bez", "ikát", "nak ", "před", "tup ", "ích ", "kud ", "ázev", " ově", "kter", "zadá", "být ", "okud", "ální", " být", " dat", " vol", "konč", "náze", " kód", "tvoř", "í se", "fiká", "adán", " sig", "ných", "změn", " sys", " žád", "nače", "syst", "ytvo", "bude", "ude ", "osti", "pisu", "byl ", " byl", " nez", "ba p", "kont", "o po", "jmén", "žádn", "stém", "ysté", "eno ", "cert", "jedn", "nou ", "nské", "ní n", " změ", "ská ", "orov", " cer", " jmé", " roz", "lika", " pra", "stan", " kte", "erti", "slo ", " vyž", " for"},
+		"de":      {"den ", "icht", "cht ", " nic", "nich", "der ", " ein", " die", "isch", " ver", "gen ", "date", "eine", "eich", "ten ", " der", "die ", "sch ", "ung ", "atei", "nen ", " aus", "fehl", " dat", "tion", "ende", "iche", "ist ", " ist", "ben ", " wer", "rden", "ren ", "mit ", "chen", "ert ", "en s", "schl", "hen ", "für ", " für", "zeic", "ein ", "von ", "nden", " von", "erde", "eben", "werd", " kon", " auf", " sie", " sch", " in ", "sche", " feh", "eren", "ine ", "iert", "sie ", " mit", "und ", "nnte", "ion ", "nte ", "n de", "erze", "kann", " zei", " und", "gebe", "tei ", "kein", " kei", "len ", "en d", "lich", "ehle", "sen ", "che ", "n si", "ltig", "gült", "ülti", "tige", "des ", " das", " wir", " sta", "nter", "ange", "gabe", "rung", "üsse", "en a", "ssel", "ausg", "t we", " ung", "rzei", "ngen", "iere", "opti", " des", " zu ", "ird ", "wird", "ann ", "ptio", "erun", "hler", " kan", " bei", "konn", "onnt", "lüss", "wend", "chlü", "ichn", "hlüs", " opt", "auf ", "das ", "chni", "nder", "ungü", "ngül", "verz", "ich ", "ler ", "dies", "erwe", "oder", " ang", " ode", "name", " git", "ter ", "hnis", "git ", " ben", " ent", "cher", "iese", " den", "er b", "unge", "eile", "n ni", "verw", "elle", "igen", "über", "vers", "nutz", "schr", "unte", "esch", "er s", "erst", "en v", "r be", "zeil", "zen ", "onen", "eite", "en w", " vor", " com", " übe", "ien ", "tell", "e da", "stel", "er a", "comm", "abe ", "gege", "en i", "atio", " unt", "ige ", "mmit", "ommi", "erte", "alte", "enut", "ione", "lle ", "tier", "esen", "nis ", "form", "rwen", "benu", "hes ", "sel ", "men ", "führ", "ches", "inde", " anz", "eige", "orma", "e ni", "egeb", " akt", "e au", "n au", "wert", "hren", "zeig", "rsch", " pro", "en b", "usge", "e ve", "n un", "eits", "er d", "en e", "tzen", "tzt ", "bere", "nisc", "ment", "erei", "ände", "setz", "utze", "n vo", "rmat", "llen", "t de", "gesc", "n be", "n ei", "als ", "beim", "eim ", "nach", "en n", "in d", "t ge", "ste ", "t ni", " wur", "arte", " nac", "sign", "uell", " als", "t ei", "t au", " wen", "chre"},
+		"en":      {"tion", "ing ", "ion ", " the", " to ", "not ", "the ", " not", " for", " of ", " is ", " in ", "and ", "ted ", " fil", "file", "for ", "ctio", "ile ", " use", " com", "atio", "ate ", "ble ", " wit", "with", "able", " con", " can", "alid", "vali", "ter ", "lid ", "ecti", "ent ", " and", "ith ", " inv", " sec", "inva", "nval", "s no", " ins", " be ", "comm", "name", "sect", "inst", "ons ", "ame ", "ions", "led ", "ptio", "ting", " opt", " err", "opti", " or ", " sta", "ster", "rror", "erro", "port", "sion", "ment", " rel", "use ", "ror ", " lin", "d to", " exp", " nam", "anno", "nnot", "ed t", "e to", "cann", " reg", " cha", "loca", "age ", "ode ", " pro", "cati", "e in", " val", " no ", "sing", " sym", "set ", " out", " fai", "fail", "ning", "ange", "rect", "iste", "e re", "iled", "regi", " ope", "mbol", " key", "symb", "ymbo", "red ", "ress", "spec", "ory ", "ect ", "aile", "ess ", "put ", "line", "e co", "e fo", "sign", "ine ", "form", "ead ", "gist", "egis", "relo", " dir", " thi", "dire", "irec", "eloc", " git", " int", "supp", "read", "his ", "t re", "this", "t be", "out ", "e th", "d in", "git ", "cted", "tern", " sig", "ocat", " spe", "ther", "stru", " add", "ian ", "ruct", "truc", "ecte", "s in", "les ", "oper", "nstr", "are ", "valu", " set", " war", "peci", " all", "ucti", " def", "orma", " ind", "nal ", "sed ", "rom ", "alue", "ppor", "tory", "ould", " as ", "uppo", "ive ", " num", " you", " fro", " rea", "pera", "inte", "warn", "from", "icat", "ase ", "nter", "n th", "ern ", "nly ", "is n", " rep", " cou", " pre", "bol ", "omma", "mber", "e of", " sup", "t of", "ecif", "only", "pect", "uld ", "pack", " onl", " mod", "rmat", "expe", "comp", "ize ", "rnin", "key ", "can ", "cont", "arni", " tha", "xpec", "ot s", "mand", "ot a", "ject", "ore ", " rem", " cre", "le t", "he s", "ort ", "nge ", "all ", "cate", "t th", "ctor", "ure ", "reat", "code", "ng t", "type", " dis", "lue ", "d no", " pac", "atch", "ed f", "numb", " if ", "ecto", "rted", " are", "size", "umbe", " by ", "on i", "ust ", "llow", " pat"},
+		"es":      {" de ", " no ", "ión ", " el ", "ción", "o de", " se ", " la ", " con", " en ", "ado ", " par", "para", "o se", "s de", "ara ", "a de", " est", "no s", " com", " un ", " des", "se p", "ació", "ido ", "cion", "e de", "ero ", "ndo ", "n de", "e pu", " pue", "pued", "ada ", "uede", "nte ", "ando", "ente", "de c", "los ", "o es", "ón d", "ento", " del", "de l", " es ", "nes ", "ecci", "ede ", "con ", "cció", "ment", "pera", "del ", "ida ", "que ", "nto ", "e co", "váli", "álid", "una ", "dos ", "r de", " que", "ones", "de s", "ione", " esp", " una", "e la", "de e", "no e", "espe", " los", "cont", "fica", " al ", "es d", "ific", "r el", "cher", "lida", "ntra", "las ", "tos ", "fich", "irec", "ar e", "e es", "esta", "dire", " fic", "e re", "hero", "iche", " dir", "e se", "do d", " sec", "ient", "o co", "ombr", "sión", "nomb", "a la", " inv", " reg", "arch", "rio ", " pro", "e en", "opci", "está", " fal", " opc", "en e", "entr", " nom", " por", "rar ", "istr", " err", "mbre", "a co", "os d", "de r", "liza", "ivo ", "tro ", " ins", "secc", "rect", "ecto", "iona", "caci", " arc", "or d", "tes ", "regi", "desc", "invá", "nvál", "bre ", "lido", "por ", "rror", "erro", "o en", "a el", "n el", "chiv", "rchi", "comp", "hivo", " ent", "icac", "oper", "cado", " ser", " ope", "gist", "egis", "les ", "en l", "stra", " las", "tado", "ror ", "de d", "do e", "a un", "mien", "tar ", "orma", "rand", "rado", "tori", "fall", " usa", "form", "a en", "ble ", "stro", "ro d", "a in", "ante", "rada", "able", "o pa", "trad", "dor ", "res ", "to d", "inst", " git", "n la", "stá ", " int", " sin", "el s", "l de", "era ", "o re", "enci", "mite", "de a", "git ", "el c", "ccio", "icad", "o in", "ra e", "olo ", "orio", "s en", "e un", "stru", "se e", " per", "lave", "o no", " act", "nstr", "spec", " obj", "obje", "ador", "bica", "ubic", "sper", " val", "inte", "ados", " cla", " cam", "clav", "e el", "das ", "ontr", "ite ", "cono", "r la", "ica ", "truc", " tra", "s co", "bjet", "onoc", "de p", "ncia", "nter", "pció", "ucci", "de u", " sal", "r un"},
+		"fr":      {" de ", "tion", "ion ", "e de", " la ", "les ", " le ", " les", "ent ", "er l", " pas", "our ", "r le", "fich", "ment", "eur ", "pas ", "ble ", "est ", " pou", " est", "atio", "le d", "s de", "que ", "pour", "de l", "des ", " com", "tre ", "ans ", " un ", " des", "ier ", "e co", "on d", "ctio", "ible", " dan", "ichi", "chie", "hier", "util", " fic", " du ", "dans", "une ", " con", "tili", "ique", "ilis", "emen", " uti", "de c", " imp", " par", "ire ", "age ", " en ", "comm", " une", "n de", " ne ", "sibl", "impo", "ssib", "poss", "es d", "ossi", "r de", "mpos", "ons ", "sion", " ave", "t pa", "e la", "ions", "e pa", "de s", "e d ", "ant ", "de d", " nom", "re d", " et ", "ur l", "non ", "ecti", "ress", "ns l", " non", "t de", "ande", " cha", "s le", "ur d", "vec ", "avec", " val", "e in", "par ", "ne p", "e po", "ide ", "ptio", "r la", " ou ", " opt", "être", "de r", " sup", "opti", "e fi", "e le", "n es", "igne", "ser ", "res ", " inc", " êtr", "alid", "ure ", "vali", "de p", "r un", " err", "lise", "es s", "lle ", "ode ", " mod", "nde ", "e no", "entr", " n e", "ous ", " ind", "che ", "icat", "supp", "istr", " ins", "e ré", "nt d", " pro", "e l ", "er d", "e re", " sec", "ture", "lign", "lide", "st p", "cher", "gist", "regi", "egis", "stre", " aff", "erre", "enti", "ées ", "corr", "port", "able", "inco", "atte", " att", " déf", "reur", "rreu", " d a", "essa", "e se", "ter ", "tten", "ffic", " inv", "affi", " rép", "teur", " ent", "le c", " l a", "fica", "ific", " peu", "sect", "te d", "ers ", "es p", "aire", "inte", "inst", "rer ", "la s", "e dé", "leur", "es c", "le f", "r l ", "la c", "ais ", "sage", "comp", "iser", "mand", " reg", "form", "aque", " que", " sur", "tes ", "orre", "stru", "sign", " ver", "inva", "nval", "de t", "ille", "e pe", "iche", " lig", "e à ", "conn", "truc", " int", "ruct", " sig", "cati", "nom ", "nstr", "vers", "dres", "oire", "rect", "ucti", "adre", "lisa", "ont ", " éch", "de f", "cont", "t êt", "her ", " tro", "e su", "stan", "le s", "quet", "nte ", "vale", "nce ", "sur ", "s in"},
+		"gl":      {" de ", "non ", " non", "ión ", "ción", "a de", " do ", " con", "o de", " est", "ació", " par", "ado ", "para", "ara ", " un ", "de s", "eiro", " se ", "ndo ", "ica ", "s de", "unha", " com", "nha ", "fich", "iche", " fic", "o no", "chei", "heir", "e de", "n se", "lica", " que", "bel ", "ada ", " des", "de c", "que ", "iro ", "on s", "ando", "ido ", "ment", "esta", "rio ", " unh", "do s", " pro", " tec", "tecl", "ecla", "blic", "e si", " pos", "caci", "ste ", "este", "e co", "públ", "úbli", "ano ", "os d", "íbel", " rep", "sign", "las ", " cha", "icac", "ico ", "ón d", "tes ", "tica", "quet", "paqu", "nos ", "se a", "sión", "osíb", "posí", "síbe", "on é", "ario", "aque", "n é ", "a co", " paq", "repú", "epúb", "ca d", "con ", " no ", "nte ", "ingu", "uete", "n pa", " por", "allo", "dos ", "n de", "r o ", " nor", "está", "ling", "r de", "rect", " da ", " esp", "igno", "ro d", "óns ", " sig", "iste", "nto ", "entr", "ar o", "gua ", " os ", "ecto", "de e", "ngua", "tos ", "ese ", " sin", "requ", " lin", " err", " req", "chav", "eco ", "have", "iona", "de d", "e un", " fal", "nal ", " aut", "se p", " car", "enti", "r a ", "rese", "do n", "gnos", "tent", "ua d", "erro", "ións", "nome", "tas ", "ento", "r un", " nom", "ific", "ente", "use ", "do d", "e se", "ros ", " ou ", "ema ", "sta ", "e no", "equí", "quír", "res ", "sina", "uíre", " pod", "fica", "pode", "tado", "ón p", " ao ", " ser", "cont", "íres", "ar a", "o si", " int", "llo ", "o pa", "stem", "ura ", "o fi", "o se", "orta", "rte ", "sist", "váli", "álid", " ins", " sis", "a in", "tema", "usua", " vál", "a a ", "suar", "uari", "clas", "inte", "pció", "tura", " usu", "e es", "ode ", "stá ", " act", " é p", "a es", "a no", "ave ", "l de", "opci", " opc", "aute", "comp", "ntic", " per", "fall", "inst", "por ", "uten", " sen", "cer ", "ino ", "se u", "é po", "ase ", "orte", "de m", "dor ", "ome ", "or d", "e a ", "e au", " a c", "de a", "elec", "ida ", "ntra", "o co", " en ", "cion", "port", "rro ", "espe", "o do", "scri", "de p", "do p", "rado", "s no", "sen "},
+		"hr":      {"ski ", "ije ", " je ", " za ", " dat", "atot", "dato", "tote", "ija ", "nje ", "otek", "nije", " nij", " pro", " se ", " pri", " pre", "cija", "anje", " na ", "ako ", "iti ", "opci", "pcij", " opc", " zna", "stav", "znak", "rije", "ili ", "e po", "e pr", " ili", "mogu", " ako", " pos", "nski", " sta", "ljan", "broj", "orij", "a za", "iran", "oguć", " ne ", "cije", "a po", " bro", " ime", "e mo", "e na", "ena ", " isp", "eka ", "teka", "rija", "redb", " nar", "tori", "kao ", " mog", "je p", " kao", "ared", "nare", " dir", "a pr", "nja ", "iše ", "o je", "a je", "jedn", "osta", "alja", "valj", "dire", "post", "ispi", " od ", "rekt", "irek", " kor", " tip", "nako", "eke ", "ana ", "teke", "ati ", "jski", " nev", "acij", "ekto", "ktor", "eval", "guće", "ika ", "je s", "kori", "anja", "neva", "je m", "ansk", "e za", " ist", "e da", "i je", "tipk", " usp", "ment", " arg", "uće ", "a na", "ima ", "umen", "i pr", "ani ", "je o", "oris", "spje", "uspj", "je n", " sig", "vanj", " zad", "argu", "gume", "rgum", "roj ", "aka ", "je u", "ina ", " koj", " pot", " izl", " pod", " jez", "jezi", "treb", "je i", " raz", "nema", "ira ", "ime ", "potr", "zlaz", "eni ", "izla", "ova ", "ijed", " upo", "edno", "e iz", "ska ", "stan", "sti ", "piše", "sto ", " sam", "vrši", "a ko", "akov", "greš", "ranj", " izv", "nog ", "a da", "nost", "kovn", "lika", "vrij", " ula", "isti", " vri", "ava ", "otre", "ovni", " sva", "form", "amo ", "ijsk", "orma", "ulaz", "laz ", "rešk", " nav", "ica ", "je d", "isto", "samo", "e ko", " jed", " red", "ano ", "nom ", "usta", " gre", "oji ", "čki ", "spiš", " zav", "avrš", "rši ", "zavr", " pok", "i po", " kon", "ko j", "i zn", "svak", "iju ", "nih ", " str", "avlj", "dni ", "spec", "e us", "i na", "mena", "rist", "ama ", "na s", "vni ", "ene ", " nem", "ema ", "nak ", "rani", "enje", "i iz", "ovje", "i s ", "a se", "retk", "čita", " spe", "nave", "peci", "mije", "vede", "za p", " ret", " rep", "ni s", " tre", "kom ", " sus", "blik", "era ", "reda", "anda", "ijen", " ukl", "eden", "i se", "ne s", "proc"},
+		"hu":      {" az ", " nem", " meg", "nem ", "ása ", "fájl", "tele", " fáj", " a k", " egy", "elen", "hasz", "aszn", "szná", "znál", "ítás", "ranc", "a a ", "apcs", "kapc", " par", "len ", "csol", "pcso", "para", " kap", " és ", "aran", " has", "ancs", " min", "soló", "vény", "ése ", "ott ", "agy ", " szi", "ett ", "ítés", " vag", " fel", "vagy", "enet", "szám", "tása", "elme", "ható", "llít", "állí", "rtel", "szer", "ncs ", "rvén", " érv", "érvé", "mene", " a f", "ájl ", "írás", "ató ", " kar", "egy ", "lítá", "az a", " cso", " ha ", "nek ", "t a ", "ényt", " a s", "ytel", "lmez", "nyte", "rend", "tés ", "kara", "rakt", " a m", "nak ", " szá", "arak", "akte", "kter", "álat", " sor", "hoz ", "lent", "nála", "érte", "llen", "eáll", "s a ", " hib", "telm", "jele", " a z", "ezés", "a me", "sége", " nin", "incs", "ninc", "a z ", "ezet", "a sz", " for", " jel", "beál", "ille", " ren", "kor ", "ment", "köny", "önyv", " beá", "nyvt", "vtár", "yvtá", "ges ", " köz", "síté", " vál", "hely", " a p", " ért", "csom", "kiír", "ssza", " kií", "omag", "ehet", "oló ", "soma", " leh", "int ", "s sz", "ban ", " sza", "lehe", "mint", "egad", "mega", " hel", "vált", "eti ", "tése", "dsze", " vis", "név ", "a kö", "ormá", "rása", "sok ", "a ki", "bill", "mind", " csa", "elha", "ends", "ndsz", " arg", "hiba", "ikus", "egye", "ező ", "form", "issz", "az e", "viss", "éges", " kön", "meg ", "rték", "érté", "enty", "ntyű", " bil", " kil", " szü", "osít", "a ka", "ben ", "övet", "ési ", "inde", "umen", "gyel", "köve", " ala", "a fá", "entu", "ntum", "het ", "argu", "em t", "kség", "kus ", "szük", "züks", "üksé", "esít", "gume", "rgum", " köv", "álto", "ltoz", "ssze", "tás ", "lis ", "szab", "tkez", " lét", " pro", "port", "ális", " sik", "iker", "ság ", "olva", "erül", "sike", "össz", " tör", "felh", "sak ", "ja a", "iírá", "ulcs", " a t", "a az", "em l", "kulc", "s ki", "emen", " a h", "figy", "igye", "lhat", " fig", " van", "imen", "kime", "szig", "k a ", "sa a", "csak", "ha a", "iba ", "lhas", "olás", " bem", "beme", "elye", "tart", " a b", "i a "},
+		"id":      {"kan ", "dak ", "idak", "tida", " tid", "ang ", " men", " ber", "ak d", "ngan", "gan ", "ntuk", "pat ", "dapa", "apat", " unt", "tuk ", "untu", " dap", "k da", "yang", " yan", " per", "enga", "t me", "ada ", "at m", " pen", " mem", "meng", "kas ", "erka", "akan", "berk", "rkas", " ter", " git", "an d", "git ", "anda", "an s", "ukan", "asi ", "ikan", "ari ", "guna", "an p", "nya ", "dari", " dar", " seb", " den", "deng", "dala", "k me", "an k", " dan", "ng d", "dan ", "alam", "nda ", " ada", "ama ", " ke ", "engg", "peng", " kom", "k di", "ngka", "unak", "gal ", "mit ", "naka", "han ", "an b", "lam ", "gaga", "an u", "agal", "komi", "omit", " dal", " gag", " di ", "g di", "an t", " and", "tkan", "uk m", "tori", "ori ", "pada", "n ke", " bar", "sebu", "lah ", "nama", " ata", "lang", "ini ", " ini", "n pe", "memb", "perl", " val", "alid", "atau", "vali", "tau ", "lid ", "baha", "n se", "tan ", "emba", "n di", "liha", "bang", "ngga", " pad", " nam", " rep", "obje", " obj", "anya", "lik ", "alan", "ris ", "ika ", "kasi", "tika", " kon", "anga", " dip", " ind", "atan", "jek ", "bjek", "hkan", "aban", "k va", "peri", "ak a", "i da", "ikas", "ran ", " cab", "buah", "uah ", "ahan", "caba", "ebua", "n un", "ak v", "amba", "tak ", "eks ", "nggu", "alah", "erik", "hon ", "ohon", "inde", "atka", "luar", " gun", " jal", "t di", "ensi", "ung ", "an a", "baru", "bung", "erin", " dir", " tan", "an m", "aan ", "a da", " pem", "dire", "l me", " ref", "al m", "ndek", "ubah", "i di", "aris", "k ad", "ggun", "uat ", "at d", "buat", " tam", "abun", "gabu", "irek", "n da", " dit", "apus", "dipe", "hapu", "ing ", "nsi ", "si d", "rekt", "aika", "buka", "deks", "iper", "lan ", "aran", "ktor", "ulan", " sta", "bari", "beri", "ekto", "eren", " mas", "ingk", "pkan", "erja", "fere", " pak", " set", "a be", "apka", "ian ", "n ti", "ng t", "oleh", " bah", "ilih", "refe", "efer", "ara ", "inta", "ring", "pus ", " han", " dik", "embu", "ng b", "rens", "n ko", "n me", "unga", "a pe", "jang", " ker", "etik", " kel", " sub", "erlu", "konf", "lai "},
+		"it":      {" di ", " non", "non ", "one ", "zion", "ione", "ile ", " del", " il ", "ato ", " con", "are ", " per", " com", "per ", " imp", "azio", "bile", "impo", "ibil", "nte ", "ment", "mpos", " un ", "dell", "poss", " fil", "file", "ossi", "sibi", "e di", " la ", "ssib", "del ", "ale ", "ific", " sta", "o di", "ere ", "ente", "e de", "lla ", "ore ", " in ", "fica", "a di", " val", "o de", "enti", "ne d", "re i", "aggi", "e no", "ata ", "nto ", "e il", " chi", "segu", "ati ", "che ", "a de", " ese", "ella", "i co", "ica ", "nti ", "chia", "ell ", "ome ", "re l", "con ", "icat", "to d", "ento", "e co", "oni ", "stat", " git", "sion", "ita ", "ioni", "alid", "vali", " nel", " mod", "git ", "tent", "eseg", "tato", "e in", " ric", "hiav", "ndo ", "n ri", "on r", "amen", " le ", " que", "ero ", "una ", "izza", "ggio", " cor", "cont", "on v", "usci", " dei", "atte", " usa", "dei ", "scit", " err", "egui", "tto ", " opz", "opzi", "pzio", "n è ", "iusc", "on è", "lizz", "ire ", "o co", "erro", " pro", "ito ", "n va", "ave ", "llo ", "ando", "comm", "sto ", "post", " pre", "iave", "cato", "e un", "o no", "ono ", "l fi", "e la", " riu", " una", "di c", "mit ", " da ", "le d", "mmit", "ommi", "re d", "rius", "ory ", " che", "i di", "tory", "ques", "la c", " int", "uest", "ese ", " spe", " nom", "rror", "sta ", " att", "osta", "port", "tti ", " ind", "spec", "iona", "inte", "l co", " agg", "n co", "indi", "ante", "olo ", " seg", "corr", "peci", "etto", "a un", "lica", "e l ", "rore", "cita", "mina", "a ch", "esto", " cre", "e ri", "mand", "re u", " all", "di s", "l in", "cifi", "ecif", " mer", "oman", " pac", "il c", " dir", "o in", "ro d", "ido ", "essi", "ranc", "nale", "anch", "etti", "e es", "lido", "coma", "a co", "ità ", "orma", "difi", "sono", "odif", " for", "lo s", "i in", "le c", "esse", "orta", "nter", "i fi", "o st", "imen", "ura ", " ess", "enta", "nome", "tten", "uten", "iste", "on s", "sser", " tro", "sist", "form", "o pe", "dire", "le a", "sso ", " dal", "ichi", "lle ", "sere", " rig", " ver", "i se", "cors", "e pe"},
+		"nl":      {" de ", "een ", " van", "van ", "stan", " is ", "tand", " nie", " ver", "esta", "iet ", "best", "niet", " een", "den ", " bes", " het", "het ", "en v", "tie ", "ten ", "oor ", "voor", " in ", "ken ", "eken", " geb", " voo", "and ", "n de", "ren ", "als ", "ing ", "word", " wor", "gebr", "isch", "brui", "ruik", " als", " aan", "ebru", " en ", "len ", "aard", "aar ", "eren", "ven ", "n va", "even", "gen ", " uit", "e ge", " met", "voer", " opt", "opti", "met ", "waar", "nder", "ptie", "geve", " kan", " sta", "an d", "kan ", " gee", "en o", "erd ", " toe", "nen ", " of ", "teke", "ande", "geen", "rde ", " naa", "acht", " te ", "ige ", "egev", "gege", "onde", "sch ", "eerd", "alle", "en a", "atie", "t ge", "ngel", "aan ", "dige", "en i", "n ge", " reg", "n be", "eldi", "nden", "rege", " pro", "lijk", "egel", "geld", "e op", "rden", "onge", "orde", "nde ", "rdt ", "ldig", " ong", "naam", "ordt", "en b", "arde", "en d", " op ", " geg", " waa", "en t", "aal ", "n in", "verw", "onen", "aam ", "en n", "ens ", " tek", "elij", "t de", "ment", "akke", "ende", "chte", "daar", "inge", "vere", "n ni", "anda", "pakk", " bij", "sche", "uike", "en m", "en g", "ijn ", "erei", "kket", "de v", "en e", "ndaa", " all", "et o", "naar", "over", " zij", " ton", "ent ", "zijn", "tone", " pak", "ies ", "n he", "en w", "n op", "erde", "cht ", "dere", "men ", " map", "map ", "oer ", "umen", " ove", "sen ", "uitv", "e va", "et v", "ngen", "itvo", "ling", "tvoe", "e in", "llen", "stel", "argu", "gume", "rgum", "vers", "e be", " arg", "en s", "e is", "de o", "der ", "de a", "che ", " con", "aans", "ties", "e ve", "ijde", "de g", "alen", "de s", "bij ", "e re", "tal ", " com", "n wo", "rach", "t op", " ins", "llee", "t be", "ands", "erwi", " die", "ings", "s ge", "inst", "fout", "drac", "oets", "opdr", "pdra", "rwij", "staa", "dit ", " dit", "ctie", "an e", "de t", "toet", "eld ", "ijk ", "reis", "e ma", "eist", "laat", "ele ", "n ee", " gro", " vol", "e vo", "ste ", "t ee", "esch", "t he", "aats", "n al", " opd", "ers ", "dig ", "t ve", "t in"},
+		"pl":      {"nie ", " nie", "nia ", "anie", " prz", "ego ", "plik", "ski ", " pli", " moż", " pod", "ie m", "owan", "ych ", "e mo", " jes", "jest", "enie", "prze", "est ", "wani", "żna ", "możn", "ożna", " do ", "ania", "cji ", " uży", "nych", "praw", "ie p", "enia", " się", "się ", " na ", "e po", "any ", "zeni", " opc", "opcj", "ieni", "ane ", "cja ", " naz", "nazw", "nego", "lucz", "kluc", "iku ", " zna", "liku", " sta", " pol", "ie z", " zmi", " zap", "apis", " pro", "owy ", " git", "cie ", "przy", "staw", "jści", "osta", " pow", "e je", " klu", "ński", "zapi", "pole", "ków ", " dla", "dla ", "poda", "ości", " sek", "a po", "olec", "lece", "stan", " roz", "ment", "git ", "ści ", "talo", "odan", "tów ", "licz", " kon", "pisa", "ie w", "tawi", "ano ", " lub", " wyp", "wier", "lub ", "ywan", "twor", "worz", "form", "zmia", "obie", "orma", "rowa", "ceni", "usta", "mien", "nej ", " jak", "łącz", "ana ", "alog", "znak", "ie j", "yfik", "ekcj", "sekc", "kata", "niep", " ust", "atal", "wane", "na p", "ypis", " kat", "ecen", " wyk", "jski", "wany", "wart", "arto", "czyt", "zost", " bra", " błą", "błąd", "dłow", "łąd ", "rawi", " błę", "błęd", "ikat", " wyj", "ika ", "rtoś", "ście", "ie u", " to ", "ość ", "fika", "anyc", "lik ", "nika", "one ", "wać ", "powi", "zyć ", "o si", "wypi", "ony ", "acji", " wie", "dpis", "tkow", " tyl", " usu", "dzie", " bez", "czas", "mian", "wyjś", "iera", "umen", "yjśc", "odcz", " zos", "ło s", "na u", "użyt", "cje ", " arg", "a pr", "zani", "zmie", " war", "awid", "o po", " lin", "brak", "idło", "widł", "uje ", " rep", "lko ", "a za", "ylko", "tylk", "czen", "e pr", "argu", "gume", "rgum", "awie", "iekt", "stęp", " lic", "ie n", "isan", "niej", "lini", "ocze", "a na", " wym", "pisu", "podp", "iem ", "ięci", " obi", "cza ", "ików", "ować", "e za", "ie s", "iwan", "owe ", "biek", "akie", "nale", "nym ", "wego", "odpi", "e na", " ind", "cia ", " zak", "oweg", "ując", "ami ", "ogra", "iepr", " ma ", "ucza", " pom", "kie ", "tnie", "na w", "owni", "ie d", "kcji", "łędn", " wys", "dany", "jące", "rzez"},
+		"pt":      {" de ", "ção ", "o de", " com", " par", "para", "ara ", "não ", " não", "ado ", "s de", "ada ", "ação", " con", "dos ", "ndo ", "a de", " em ", "eiro", "ment", "ando", "ido ", " um ", " est", " do ", "vel ", " fic", "de s", "nte ", "o co", "fich", "com ", "chei", "iche", "heir", "ões ", " des", " for", "ão d", " esp", "iro ", "ente", "o pa", "de c", "uma ", "ível", "espe", "o in", "ções", "e de", "que ", " que", "cont", "nome", "fica", "são ", " ou ", "ific", " nom", " fal", "nto ", " opç", "ento", " por", "rio ", "e co", " ser", " pro", "ntra", "arqu", "rqui", " inv", " arq", " usa", " uma", "ivo ", " da ", " inc", "a pa", "por ", "váli", "álid", "cess", "es d", "tes ", "quiv", "ados", " lin", "efin", "defi", " se ", "liza", "trad", "poss", "rada", "inha", " imp", "e se", "uivo", "orma", " ao ", "linh", "a co", "os d", "invá", "nvál", "síve", "ssív", "ossí", "das ", "sta ", "ome ", "spec", "form", "de e", "inte", "tar ", " err", "ida ", "rado", "de a", "tado", "rar ", "falh", "entr", " ent", "o fo", " int", "erro", "ria ", "fini", "ão p", "alho", "mand", "ário", "comp", "ser ", "oman", "do d", "ica ", "do p", "coma", "peci", "nter", " no ", "tos ", "ros ", " pre", "aliz", "pção", "capa", "ador", "a se", "rro ", " os ", "de p", " def", "loca", "ro d", "opçã", "apaz", "o re", "r de", "paz ", "inca", "ncap", "ais ", "a a ", "lido", "ída ", " ver", " pos", "omo ", "res ", "como", "o se", " car", "amen", "to d", "aída", " saí", "saíd", "r o ", "port", "dor ", "iste", "a li", "esta", "argu", "iona", "s co", "o é ", "lica", "ecif", "valo", "icad", "cifi", "da p", "o es", " arg", " rem", "prim", "cion", " pod", "proc", "nha ", "o do", "de f", "ão f", "r um", "tual", "pode", "onte", " git", "ar a", "stra", "de d", "o po", "e o ", "ão e", "tent", "remo", "cada", "e a ", "umen", " tam", "do c", " exe", "git ", "tóri", "lha ", " sem", " pad", "padr", "a o ", "ar o", "desc", "ver ", " tra", "rão ", "o no", "corr", "e re", "s pa", "e pa", "impo", "mpos", "pera", "ão s", "e es", "gume", "rgum", " esc", "ico ", "açõe", "ero "},
+		"ro":      {" de ", "are ", "ste ", " nu ", "rea ", "este", " est", "ate ", "e de", "tru ", "țiun", "area", " în ", "entr", "ază ", " pen", "ntru", "pent", "ează", " num", "oare", "care", "iune", " se ", "lui ", "ată ", " cu ", "ului", " com", "ele ", " la ", " int", "ment", "ile ", "șier", "fiși", "ișie", "nume", " fiș", "ific", " un ", "a de", "opți", "pțiu", "liza", "rul ", " și ", " opț", "util", "nu s", "nea ", "tili", "iliz", "inte", " ero", " uti", " con", "unea", "oate", " pro", "ent ", "eroa", "roar", "fica", "e in", "i de", "nter", "iuni", "alid", "vali", "ul d", " sau", "l de", "sau ", "ă de", "chei", "e în", "e ne", " car", "tare", "e po", "ire ", " să ", "cțiu", " che", " poa", "poat", " sec", " sta", "de s", "e co", "nu e", "une ", "loca", " nec", "u se", "ator", "ică ", "ere ", "eză ", "tor ", "se p", "izat", "e fi", "re d", " din", "te d", "rare", "eval", "u es", "de c", "e re", " ace", " val", " nev", "tul ", "ară ", "u a ", " pre", " rea", " sim", "e nu", "ea d", "ită ", "neva", "e pr", "proc", "ume ", "ecți", " ins", "lor ", "semn", "comp", "ru a", "ă fi", "ană ", "abil", "acă ", " înc", "aloc", "ște ", "de i", " afi", "lul ", "mbol", "real", "rect", " fie", "afiș", "ocar", " sun", "imbo", "simb", " des", "erul", "ie s", " sem", "secț", "ă pe", "ește", "roce", "tern", "erti", "e se", "te n", " exp", "siun", "oces", "stru", "e pe", "le d", "valo", " ca ", "ție ", "epta", "re a", "te a", "spec", " mod", "form", "intr", "șire", " dac", " ieș", "dacă", " ave", "ieși", "t de", "lica", "din ", "icat", "orma", "iere", "stă ", " fi ", " dir", "e la", "tast", "ecun", " tre", "ealo", "scri", " tas", "ier ", "mand", "uni ", "eșir", "vert", "tate", "te c", "ați ", "e di", "oman", "port", "tat ", "te f", "te p", "tifi", " arg", "istr", "mai ", " înt", "dire", "irec", "lid ", "lini", " pot", "re n", "ieru", "regi", "coma", "aver", "ebui", "rebu", "sunt", "de l", "trar", "treb", "cesa", "isme", "rtis", "smen", "tism", "fișe", "șeaz", " rep", "ișea", "e ca", "i în", "umen", " aut", " spe", "blic", "anda", "cuno", "e să"},
+		"ru":      {" не ", "ние ", "ать ", "ение", "тся ", "ный ", "ния ", " для", "для ", "ить ", "ется", " про", "файл", " фай", " пер", "поль", "ольз", "ения", "вать", "пере", " исп", "спол", "оват", " раз", "испо", "ого ", " уда", "стро", "удал", "пред", " под", "ключ", "зова", "рова", "иров", " пре", "кая ", " при", "ован", " ком", "не у", "льзо", "ьзов", "ская", "ные ", " нев", " сим", "анны", "имво", "мвол", "симв", "чени", " пар", " оши", "ошиб", " пол", "е уд", "ное ", "ции ", "шибк", "ного", "лось", "ось ", "реме", " зап", "щени", "алос", "пара", "ных ", " стр", "дало", "амет", "аетс", "стан", "знач", "жно ", "ная ", "метр", "держ", "арам", "раме", " на ", "или ", "ибка", "трок", "прав", "вани", "наче", " рег", "бка ", "каза", " или", "опер", "пера", "ачен", "полн", "тель", "льны", "истр", "е по", "ание", "лени", "льно", "нени", "реги", "гист", "егис", "ной ", "уетс", " ука", " опе", "можн", "ерем", "ещен", "пуст", "ожно", "ть п", "стру", " клю", "меще", "кий ", "трук", "инст", " зна", "ельн", "указ", "нстр", "разд", "азде", "здел", "нный", "рукц", "укци", "ержи", "мент", "ть с", " инс", "ифик", "фика", " кон", " неп", "ает ", " по ", "влен", "возм", "змож", "озмо", "обра", "льзу", "ский", "ыть ", "опус", "рект", "альн", "не п", "данн", "ател", "верн", "ству", "тный", "айл ", " из ", "ольк", "тифи", " зад", "ть в", "ката", "лько", "оман", "ько ", "ивае", "манд", "форм", "долж", " пос", "жени", "кома", "усти", " это", "изме", "жива", "нево", "при ", " изм", "евер", "айла", "емещ", " дол", "емен", "змен", "ител", "ует ", "зада", "орма", "вает", " быт", "ичес", "быть", " код", "евоз", "ний ", "подд", "одде", "едел", "ддер", "тало", " выв", "ектн", "жида", "ожид", "неве", "икат", " git", "мене", " как", "вест", " кат", "алог", "реде", " нек", "нных", "енны", "допу", "азан", "ть и", "атал", "йла ", " сли", "ания", "неко", "тано", "git ", "озда", "посл", "созд", " что", "дени", "если", "сли ", " есл", "кции", " уст", " соз", "режд", "анов", "лов ", "ранд", "ция ", "еран", " нео", "осле", "мер ", "нные", "корр", "рабо", " тол"},
+		"sk":      {"nie ", " sa ", " je ", " pre", "ina ", "súbo", "úbor", " nie", "ovan", " na ", " súb", "e je", " nep", "ie j", "čina", "enie", "použ", " prí", " pou", "uje ", "možn", "plat", "latn", " pri", " chy", "chyb", " mož", " pod", "prík", "žné ", " bal", "epla", "ožné", " ale", "nepl", "ríka", "íkaz", "alík", "balí", "vať ", "je m", "anie", "kľúč", "ého ", "ovať", " kľú", "ouži", "e mo", "ská ", "stav", "a pr", "ebo ", "stup", "aleb", "hodn", "lebo", "ika ", " rep", "publ", " voľ", "aný ", "ubli", " náz", " pro", "voľb", "blik", "epub", "lika", "repu", "osti", " adr", " kon", "riad", "adre", "pre ", "znam", "dres", "e po", "sti ", "ých ", "ané ", "bor ", "podp", "tný ", "vaný", "je p", "odno", "ské ", " nas", "sa n", " ria", "ako ", "ený ", "ozna", " ako", "oru ", "asta", "dnot", "e sa", "užív", "žíva", "e pr", "je s", "nost", "resá", "atný", "a ne", "hyba", "rova", "yba ", "cia ", "esár", "písa", "á re", "boru", "ené ", "vani", "cie ", "tvor", " zna", "ká r", "užit", " hod", "vate", "sko ", "ného", "a po", "ktor", "číta", " vyp", "orov", "ateľ", "ment", " sys", "inšt", "syst", " ned", "nšta", "zov ", " zly", "názo", "stém", "ysté", "zlyh", "ázov", "vané", "štal", " akt", "lyha", "nia ", "ouží", "znak", "aktu", "nepo", "ívat", " bol", " nem", "ožno", " ak ", "konč", "kov ", "odpo", "vyko", "ykon", " kto", "lova", "ová ", "pri ", "yhal", "kaz ", "ných", "o pr", "oľba", "tie ", "form", "nast", "ľba ", " ver", " vyk", "aven", "ormá", " spr", " vyt", " výr", "ie s", "ný p", "tave", " arg", " bez", "práv", "ský ", "vytv", "a na", "pred", "výra", "ýraz", " pot", "sled", "a sa", "e to", " vst", "á sa", " zoz", "a vy", "epod", "hell", "zozn", " she", "e na", "iadk", "né p", "shel", " neb", " nez", "ba p", "edá ", "prav", "toto", " tot", "odst", "riť ", "talo", " jaz", " ods", "azyk", "dstr", "jazy", "ota ", "rov ", "vere", "alo ", "nedá", "vori", " pos", "nota", " zad", "je n", "over", "umen", "zada", "čaká", "nam ", "aliz", "lo s", "tick", "yky ", "zyky", " sú ", "alov", "aní ", "dpis", "nčin", "odpi", "ácie", "ľúč ", "erzi"},
+		"sl":      {" pre", " ni ", " je ", "ska ", " pri", " dat", "tote", "dato", "atot", "otek", "ina ", "nje ", "anje", " za ", " na ", "čina", "ščin", "mogo", "ogoč", " ime", " upo", "i mo", "ega ", " izb", " se ", "pora", "ni m", " in ", " mog", "orab", "zbir", "upor", "goče", "ika ", "štev", " pod", "oče ", " izp", "nost", "izbi", "ali ", " sta", "ilo ", "znak", "e na", " ali", "elja", "izpi", " zna", " šte", "velj", "evel", "ljav", "iti ", "vrst", " tip", " nev", "teke", " vrs", "e pr", "imen", "eke ", "neve", "evil", "tevi", " raz", " kot", "kot ", "ukaz", " uka", "blik", "e po", "nega", "javn", "stav", "pake", "ija ", "rsti", "stan", " ne ", " če ", "a pr", "stic", "ena ", "je p", "a na", " nas", "nih ", "sti ", " nap", " dol", " pak", "meni", "pisa", "tev ", "tipk", " kon", "itev", "ski ", "aket", "poda", " pos", "lika", "e za", "prem", "spre", " spr", "i na", "napa", "a po", "i iz", "i pr", "ira ", "o na", " nam", "nja ", "nska", "apak", "name", "asta", "pri ", "ljen", "osti", " izv", "i po", "e iz", "a za", "ment", "sto ", "ška ", "bira", "nik ", "e je", "nast", "ni p", "o po", "a ni", " arg", "a iz", "ana ", "loči", " pro", "pred", " nav", "ava ", "aka ", "upin", " rep", "e da", "je n", "ubli", "umen", "uspe", "ime ", "publ", "redn", "tavi", "epub", "repu", "sled", "ezna", "reme", "tran", " pov", "ranj", " zap", "ica ", "ost ", "argu", "gume", "ni u", "o ko", "rgum", "zpiš", "prav", "stra", "zhod", "zpis", "enik", "janj", "jska", " usp", "ati ", "dolo", "edno", "je s", "ndar", "oloč", "teka", " pol", "vno ", "amo ", "ite ", "ka p", "vni ", "avna", "nika", "teva", "vilo", " izr", " vho", " vse", "mest", "rani", "vhod", " izh", " pis", "ine ", "izho", "vede", " ki ", "eni ", "na v", "vna ", "ani ", "klju", "ljuč", "nak ", "i us", "o pr", "a da", "anja", "na p", "tve ", "pove", "rabi", "a je", "emen", "eno ", "ni i", "ice ", "izra", "e ni", "hodn", "anda", " bre", "ansk", "avit", "veza", " ust", "akov", "tov ", "eden", "nave", "ovez", "spel", "avlj", "avni", "eka ", "i za", "na s", "nako", "očil", "vred", " sam", "brez"},
+		"sr-Cyrl": {" је ", " пре", " да ", " не ", " дат", "ије ", "дато", "отек", " за ", "тоте", "атот", "ање ", "ска ", " про", "ања ", "ује ", "прав", " под", "ски ", " кор", " на ", "ија ", "кори", " при", "е мо", "е пр", "е по", " са ", "а пр", "у да", "спра", "не м", "није", "је п", " ниј", "могу", "или ", "а по", "ење ", " мог", "испр", "ако ", "сти ", "а на", "орис", " гре", "опци", "греш", "е на", "огу ", "пциј", "реме", " се ", " опц", "азив", " пос", " ста", " или", "одељ", " наз", "е да", "решк", " неи", "нази", "шка ", "неис", "еисп", "о је", " упо", "рист", "ава ", "број", "гу д", " ако", "прем", "спис", " бро", "ешка", " оде", " исп", "ван ", "исти", " ред", "ција", " вре", " сим", "имбо", "мбол", "симб", " изл", "има ", "едно", "еке ", "ност", "ориј", "изла", "став", "злаз", "теке", " нис", "тека", "тање", "редб", "а за", "оста", "ика ", "ити ", "ека ", "вели", "као ", " изв", " као", "ешта", "озор", "позо", "тори", "равн", "упоз", "ена ", "рење", "ова ", "рава", " неп", "а да", "је н", " нар", "пису", "редн", "испи", "мешт", "успе", "е за", "орењ", "шава", " пра", "днос", "зоре", " вел", "ног ", "ције", "је д", "аред", "држа", "наре", "исте", "нема", "ено ", " бит", "исуј", " зна", "ина ", "личи", " арг", "сује", "вара", "непо", " дир", " усп", "а је", "кључ", "а ко", "ичин", "дире", "ирек", "рект", "треб", "зив ", "чита", " сам", "авањ", "знак", "озна", "ктор", "лазн", "екто", "емеш", "мент", "рију", "елич", "а из", "вања", "ијум", "о да", "поде", "умен", "је с", "а се", "авља", "аван", "да п", "е из", "осно", " пок", "аргу", "гуме", "ргум", "ени ", "и пр", " од ", "рој ", "штањ", "ниса", " раз", "да с", " нав", "авна", "твар", "а не", "улаз", " кој", "потр", " ист", " ула", "аје ", "подр", "ним ", "ака ", "је у", "а са", "само", "деља", "вање", "ном ", "анск", "ељак", "снов", "бити", "вред", "теку", "ост ", "пост", "отре", " осн", "ком ", "кциј", "нски", "вна ", "ости", "ара ", "ата ", "ене ", "и на", "на с", " одр", "стан", "е са", "позн", " сва", "ите ", "сто ", " мож", "е до", "тављ", "врем", "одре", "а у "},
+		"sr-Latn": {"ski ", "nski", "ija ", "ika ", "ški ", "lika", " rep", "ansk", "blik", "epub", "publ", "repu", "ubli", "ska ", "jski", "jezi", " jez", "ici ", "nje ", "ezic", "zici", "i je", "ki j", "anje", "rski", " ost", "ijsk", " pak", "nija", "ostr", "strv", "lski", "rija", "aket", "tski", "pake", "a re", "dski", "land", " sev", "arsk", "rva ", "trva", "iran", "nja ", "ever", "seve", " san", "stan", "čki ", " kra", "ka r", " juž", "ana ", "južn", "ari ", "eški", "ije ", "ina ", "iški", "ki d", "eti ", "insk", " man", " mar", " pis", " pro", "alsk", "isto", "ndsk", " kor", "ajr ", "anja", "šajr", " sve", "ara ", "eta ", "ijan", "sko ", "svet", "vern", " gor", " kar", "atsk", "inst", "ismo", "pism", "smo ", "stal", "ani ", "nska", "nsta", "tan ", " pre", " sta", " za ", "cija", "ka o", "lija", " fra", " ins", " ist", "je p", "tali", " kan", "ava ", "star", "stoč", "točn", " dol", "fran", "jans", "mski", "ova ", "and ", "anij", "apad", "aški", "olar", "zapa", "čni ", " gre", " zap", "a os", "alij", "anta", "dola", "ena ", "lar ", "nta ", "ong ", "orij", "tral", "veti", " nar", " nij", "a ma", "ama ", "amsk", "ands", "dna ", "e na", "i do", "ki k", "padn", "raj ", "tska", "vina", "čka ", " je ", " mon", " mor", " nor", " pri", " sen", " val", "alje", "avlj", "ažur", "gorn", "i pa", "ka k", "onsk", "ornj", "rni ", " ara", " ažu", " kat", " mal", " nov", " obl", "dija", "kata", "keta", "nga ", "oški", "rira", "tanj", "tari", "tori", "urir", "žni ", "žuri", " gva", " han", " kon", " pal", "a ka", "alir", "ang ", "ast ", "avan", "blas", "drža", "end ", "eni ", "ensk", "evin", "kraj", "kral", "last", "lira", "ljan", "mora", "nije", "nji ", "obla", "užni", "vanj", " dat", " dob", " kal", " kom", " na ", "a pa", "ički", "janj", "ka s", "ko p", "ljev", "o pi", "otek", "očni", "ralj", "ranj", "rats", "sent", "ton ", "vest", "vski", " bur", " dem", " drž", " ind", " rad", " sam", "aka ", "ala ", "bija", "dato", "ent ", "erni", "i kr", "jevi", "ling", "na d", "nški", "raln", "ržav", " bra", " kam", " nem", " neo", " par", " pok", " pon"},
+		"tr":      {" bir", "yor ", " içi", "için", "bir ", "çin ", "llan", "ulla", "kull", " kul", " değ", "osya", "dosy", " dos", "leri", "lanı", "ları", "eri ", "lama", "deği", " geç", "işle", "inde", "ası ", "arı ", "ekle", " işl", "geçe", "eçer", "iyor", "leme", " ile", " baş", " yaz", "adı ", "ini ", "ile ", "siz ", "ıyor", " ve ", "erin", "eğiş", " seç", "arak", "rsiz", "den ", " ola", "ersi", " hat", "madı", "hata", "ler ", "çers", "ında", "ştir", "şlem", "ını ", "bili", " son", "dan ", "amad", " yer", " diz", "ılam", " git", "iste", "nda ", "klen", "lar ", "sya ", "dizi", "git ", "nde ", "seçe", " bel", "rak ", "eçen", "alar", "çene", "anım", " yok", "esi ", "sayı", "ine ", " ger", " vey", "eya ", "veya", " çık", " bil", "izin", " yap", "arın", "lara", "elir", "beli", "lan ", " bu ", " ver", "anıl", "ili ", "atır", "ama ", "alı ", "satı", " yen", "yeni", " çal", "olar", "alış", "çalı", "land", "tiri", "lenm", " say", " dil", "meye", " ana", "ilir", "lene", "ması", "kler", "andı", " sat", "eğer", " olm", "eyen", "olma", "yen ", " kar", "nın ", "ahta", "anah", "htar", "naht", "tanı", "mıyo", "miyo", "yok ", "dili", "veri", " gün", "ırıl", "asın", " olu", "esin", "lik ", " par", " ara", " kom", "komu", "n bi", "omut", "erek", "lirt", "ştır", " bağ", "sini", "ırma", "eme ", "elle", "yapı", " gir", "iril", "eşti", "leşt", "lir ", "nama", "k iç", " kal", "amıy", "arla", "gere", "eğil", "enek", "nmey", "birl", "dır ", "irme", "ıştı", "endi", "tırı", "irle", "abil", " pak", "değe", "nin ", "oluş", "mış ", " doğ", "ak i", " ind", "aket", "pake", "urul", "medi", " sem", "eler", "mbol", "esne", " nes", "i bi", "semb", "ğişi", "mak ", "nesn", "embo", "işti", "nıml", "ğil ", "ndek", "syas", "sını", "miş ", "tır ", "lana", "rula", "a bi", "anın", "ir d", "sız ", " alt", "ayar", "ilin", "uştu", " sür", " var", "irdi", "yası", "ken ", "luşt", "rini", "apıl", "ayı ", "rını", "azma", "yazm", " dal", "rleş", "ıldı", "ıktı", "çıkt", "dırı", "ağla", "ştur", "ndır", " gör", "tar ", "alan", "gird", " kon", "maya", "ayan", "yala", "ster", "ğişt"},
+		"uk":      {"ння ", " не ", "ення", "ання", "ати ", "пере", "ька ", "ний ", " пер", " роз", "ська", " вик", "рист", "ого ", "вико", "кори", "орис", " для", "для ", "не в", "уван", " про", "ванн", "ити ", "знач", "ачен", "вати", "стан", "икор", "файл", " фай", "ено ", " пом", "наче", "омил", "поми", "опер", "ано ", "милк", "уват", " від", "рект", " під", "еред", "них ", "ченн", " пар", " зна", "коре", " при", "орек", "ектн", " вка", "ного", "розд", "зділ", "озді", "пара", "вдал", " сим", "имво", "мвол", "симв", "дало", "ося ", " до ", "вказ", "лося", " нек", "алос", "каза", "неко", " вда", "екор", "е вд", "ути ", "арам", " ком", "раме", "метр", "ться", "ься ", "амет", "стов", "лка ", " мож", "илка", "тову", "иста", "исто", "трим", "ції ", "бути", " на ", " зап", " рег", "азан", " наз", " вив", "або ", " бут", "регі", " дан", "істр", " або", "має ", "назв", "ключ", "твор", "женн", " тип", "гіст", "пера", "егіс", "изна", "нськ", "танн", "тано", " зав", "стру", " опе", " ряд", "альн", "ня п", "трук", "ство", "сува", "ти п", "йськ", "нстр", "ти в", "тний", "не п", "рукц", "кома", "укці", "запи", " інс", "ован", "інст", "ктни", "вува", "манд", "овув", " нев", "рядк", " ств", "сть ", "оман", "хідн", "ресу", "ами ", " поп", "лів ", "мент", " час", " клю", "відо", "єтьс", "попе", "зано", " якщ", "кщо ", "якщо", "апис", " пов", " пів", "тува", "ані ", "тів ", "ідом", "овід", " кор", "рити", " код", " має", "е ви", "ним ", "о ви", "ому ", "пові", "влен", "джен", "есув", "вий ", "ифік", " змі", "айл ", "фіка", "ерес", "не м", "вста", "ий р", " вст", "аних", "ість", "анов", "чено", " пот", " за ", "денн", "редж", "ова ", " ста", "визн", "розм", "кий ", "ти д", "жна ", "форм", "ожна", "можн", "озмі", "я по", "анда", " мал", "підт", "овий", "ранд", "льни", " неп", "аний", "еран", "ти р", "змін", "час ", " сер", "ійсь", "дани", "кції", "айла", "дтри", "ідтр", "икон", "верш", "ікат", "авер", " нем", "вано", "едже", "заве", "змір", "неві", "на в", "орма", "орит", " обр", "виве", "е мо", " поз", "рів ", "тифі", " діа", " вир", "умен", "а ви"},
+		"vi":      {"ông ", "hông", " khô", "khôn", "ng t", " các", "g th", "ng c", "các ", "tin ", " tin", " thể", "thể ", "ược ", " tiế", " tập", " đượ", "được", " có ", "ập t", "tập ", "p ti", "tiến", "ùng ", "ng đ", "cho ", " chu", " cho", "iếng", "ếng ", " số ", "ong ", " một", "một ", " tro", "rong", "tron", " lỗi", "lỗi ", "định", " địn", "ịnh ", " là ", "ng h", " tha", "n th", " của", "của ", " khi", " chỉ", "dùng", " dùn", "khi ", "với ", " với", "chỉ ", "y ch", "mục ", " mục", "ng n", " hiệ", " và ", " ra ", " đã ", "chọn", "họn ", " thư", "ng k", "hay ", " đối", "đối ", " tên", "n ch", " git", "tên ", "i kh", "uyển", "chuy", "huyể", "yển ", "git ", " chọ", " lại", "lại ", "iên ", " bỏ ", " để ", " ký ", " đầu", "đầu ", " tùy", "iệu ", "ác t", "c th", " hợp", "hợp ", "ạng ", "g ch", "ình ", " gặp", "gặp ", "ng d", "lệnh", "ệnh ", " lện", " gia", "n tr", "ích ", "kết ", "òng ", "ang ", " kết", "tùy ", " nhậ", " vào", "vào ", "n gi", "ường", "ng p", "ặp l", "ờng ", "i ch", "ùy c", "iện ", "iểu ", " ghi", "ng l", "ghi ", "ách ", " bị ", "thư ", "p lỗ", " từ ", "ng v", "ánh ", "ng b", "ượng", "ợng ", "thay", " chi", " bản", "ng m", "g ph", " việ", " tự ", " phả", "i th", "phải", "đổi ", " đổi", "hải ", " phầ", "g kh", "hần ", "phần", "dòng", " dòn", "ộng ", "đặt ", " đặt", "hư m", "iến ", "ống ", " chư", "iệc ", "việc", "có t", " bộ ", "ợp l", " lệ ", "ư mụ", " lần", "ành ", "lần ", " hiể", "hiệu", " đan", "ần c", "giao", " như", "p lệ", "iao ", "iển ", "nh c", " này", "này ", "anh ", " the", "ảnh ", " thị", "hưa ", "thị ", "ối t", "m ch", " kiể", "chưa", "đang", "heo ", "hân ", "theo", "hành", "g có", " bạn", "bạn ", "g hợ", " tạo", "tạo ", "oặc ", "ỗi k", "ển g", " trư", "ụng ", " dụn", "dụng", "g đư", "hiện", "hiếu", "iếu ", "n kh", "ung ", "iều ", "i tư", "ển t", "hiển", " hoặ", "g tr", "hoặc", "tượn", " qua", "g nh", " tượ", " thứ", "ng g", " kho", " làm", " trì", "làm ", "ảng ", "rình", "t th", "trìn", "c ch", "sai ", " thi", " nếu", "nếu ", " cần", " sai", "cần ", "dạng", "dẫn ", " con", " đọc", "đọc ", " dẫn", "a ch"},
+	},
+	5: {
+		"ca":      {" per ", " s ha", " fitx", "fitxe", "itxer", "s ha ", " no s", " de l", "s de ", "no s ", "ació ", " els ", "txer ", "per a", "o s h", " amb ", "a de ", " ha p", " les ", " del ", " una ", "er a ", "ment ", " no e", " de c", "ogut ", " pogu", "pogut", " que ", " està", "a pog", "ha po", "no es", " opci", " git ", "ions ", " es p", " espe", "ió de", "a la ", "o es ", "es po", " de s", " pot ", "forma", " esta", "es de", "ció d", "està ", "ifica", "t de ", "e de ", "aquet", "paque", "litza", "vàlid", "ostra", "l fit", "r el ", " nom ", "e la ", " comp", "o és ", "tori ", "el fi", "stra ", " no é", "no és", "ó de ", " líni", " most", "mostr", " vàli", " s es", "s pot", "ector", "espec", "direc", "r de ", " el f", "irect", "ordre", "canvi", " erro", " ordr", " dire", "de l ", "speci", " de f", " cont", "de la", "ssió ", "de co", " canv", "ecte ", "recto", " la c", "escri", "s est", "ctori", "cions", "error", " aque", "ament", "pció ", "ar el", "quest", "ecifi", "pecif", " paqu", "aques", "alitz", "er de", "opció", "actua", "txers", "xers ", " form", " actu", " comi", "missi", "ctual", " entr", "comis", "omiss", "rror ", "a el ", " com ", "a com", " de t", "ents ", " en l", "bject", " nomé", "només", "objec", "omés ", " obje", "ades ", "ínia ", " conf", "línia", "rada ", " el c", "entra", "at de", "cific", " el p", " cap ", "jecte", "ntrad", " bran", "re de", " inte", "anca ", "icaci", "s per", " exec", " escr", "rdre ", "a un ", "stat ", " a la", " de d", "s en ", " desc", "execu", "r la ", " el n", " la s", "rènci", "ument", "cació", "erènc", "ferèn", "àlid ", "branc", "ndex ", " de p", "scriu", "de ca", " argu", "argum", "aràct", "caràc", "gumen", "rgume", "ràcte", "àcter", " carà", " sens", " valo", "ncia ", "valor", " un e", "quet ", "trada", "ense ", "sense", " en e", " trob", "e con", "ormat", "pcion", "om a ", "índex", "r en ", " segu", "cada ", "uest ", " índe", "el no", "refer", " sort", "nom d", "opcio", "rtida", "t un ", "tida ", "com a", "t per", "stabl", "xer d", " el d", "bre d", " empr", "issió", "sorti", "ència", " crea", " l ar", "xecut", "l nom", "s vàl", "de fi", " a l ", "inter", "s del", "ranca", "or de", "en el", "es re", "signa", "confi", "tura ", " la l", " és v", "ortid", "tat d", "ciona", "a els", "troba", "a les", "elimi", "rmat ", "és và"},
+		"cs":      {"oubor", "soubo", " soub", " pro ", " chyb", " klíč", " použ", "vání ", " přep", " nelz", "elze ", "nelze", "ování", "přepí", "řepín", "epína", "pínač", "platn", "nebo ", " nebo", " přík", "použi", "příka", "říkaz", "ubor ", " znak", "tina ", "adres", "boru ", "uboru", " adre", " není", "není ", "astav", " jako", "ština", "jako ", " při ", "ovat ", "eplat", "nepla", " nepl", "nasta", "klíč ", "dresá", " podp", " nast", "resář", "vatel", "rován", "chyba", "hyba ", "ument", "argum", "gumen", "rgume", " argu", " řádk", "klíče", "ínač ", "oužit", "hodno", " kláv", "ivate", "uživa", "živat", " vytv", " čísl", " uživ", "kláve", "láves", "atný ", " selh", "píše ", "latný", "selha", "elhal", "stave", " vypí", "líče ", " balí", "taven", " vstu", "vstup", "dován", "odnot", " poku", "váno ", "jsou ", "vypíš", "ypíše", " výst", "odpis", "podpi", "výstu", "ýstup", "ické ", "tifik", "ření ", " je p", "stup ", "jící ", " ověř", "ováno", " hodn", "okud ", " být ", "název", " náze", "fikát", "ifiká", "zadán", " před", " zadá", "ných ", "vytvo", "ytvoř", " syst", "pokud", "bude ", " žádn", "systé", "ystém", "osti ", "nské ", " je v", " jmén", " cert", " změn", "a při", " kter", "certi", "ertif", "ázev ", "e se ", "rtifi", "stupn", "znak ", "ního ", "oužij", "ní po", "ověře", "věřen", " každ", " kont", "ení p", "ba př", "vyžad", "yba p", "žadov", " vyža", "ného ", "užití", " přes", "chybn", "shell", "žití ", " se n", "použí", "íkaz ", " stan", "adová", " form", " jsou", " shel", "ující", "proce", " jedn", "formá", "číslo", " sezn", "eznam", "sezna", " aktu", "e pou", "obraz", "ínače", "andar", "atele", "ormát", " kód ", " proc", "ndard", "stand", "tanda", " pouz", "e pro", "ouze ", "pouze", "balík", " neby", "nebyl", "tele ", " pros", "roces", " bude", "dardn", "je vy", "ment ", "řetěz", "lika ", "publi", "ální ", " prav", "ardní", "yžado", "zení ", " repu", "epubl", "repub", "ublik", " prom", " řetě", "blika", "oměnn", "promě", "roměn", "halo ", "lhalo", " všec", "všech", "vení ", "odstr", "e li ", "jedno", " na s", " návr", " sign", "dstra", "návra", "zobra", "ávrat", " plat", " zobr", "ěření", " nepo", " odst", "e vyž", "výraz", "íslo ", " posl", "odkaz", "ován ", "í se ", " bez ", "esář ", "o sou", "uje s", " výra", "avení", "stupu", " odka", "ový k", " data", "e sou", "polož"},
+		"de":      {"icht ", "nicht", " nich", " die ", " der ", "isch ", "datei", " date", " ist ", "rden ", " für ", "zeich", " eine", " von ", " werd", "werde", "erden", "nden ", " fehl", "chen ", " sie ", "eine ", "atei ", " kein", " und ", " mit ", "geben", "fehle", "eben ", "ültig", "gülti", "nnte ", "tion ", "eiche", "eren ", "en si", "ltige", "ische", " wird", "iert ", "wird ", "erzei", "ption", "optio", "rzeic", " konn", "ehler", "konnt", "onnte", "t wer", " des ", "schlü", "n sie", "eichn", "chlüs", "ngen ", "hlüss", "lüsse", "üssel", " das ", " opti", " kann", "kann ", " ungü", "ngült", "ungül", " ausg", "enden", "ieren", " dies", "oder ", " oder", " schl", " auf ", "erung", "verze", "chnis", "ichni", " git ", " ange", "n der", "n nic", "ichen", " den ", "keine", "ungen", "diese", "hler ", " verw", " ein ", "zeile", "wende", "unter", "verwe", " über", "stell", " comm", "ation", "gabe ", "commi", "ommit", "onen ", "igen ", " unte", "er be", "liche", "enutz", "ionen", "en de", "erwen", "rwend", "benut", "tione", " vers", "ches ", " benu", "esen ", "egebe", "gegeb", "e nic", "ssel ", "hnis ", "ausge", "icher", "e ver", "e dat", "nisch", " zeil", "forma", "änder", "nutze", "tzen ", "ormat", " verz", "gesch", " beim", "beim ", "llen ", "sche ", "n ein", "berei", " nach", "t ein", "eigen", "chrei", "schre", "t nic", "ersch", " als ", "cht a", " wurd", "wurde", " in d", "ngege", "mmit ", "ateie", "paket", " wenn", "wenn ", " nur ", "zeige", "lich ", "angeg", "ann n", "rung ", "teien", "hreib", "tige ", " sign", "efehl", "kein ", "en au", "endet", "stand", " aktu", " aus ", "schen", "befeh", "hren ", "r bei", "eien ", "en vo", "arbei", "nn ni", "rbeit", "nters", "en we", " zum ", "e ein", "ler b", "ndet ", "ngabe", "ument", " pake", "ierun", "lten ", "n wer", "n von", "n ver", "anzei", "nzeig", "en un", " obje", "name ", "branc", "ranch", "argum", "gumen", "rgume", " anze", " befe", " bran", "bjekt", "cht e", "halte", "ausga", "inen ", "sisch", "ssen ", "cht g", "lisch", "namen", "sgabe", "signa", "usgab", " stan", "agen ", "der s", "objek", "eilen", "erste", "n und", " zeic", "einen", "urde ", "ierte", " argu", " eing", "andar", "ellen", "ende ", " bere", "en be", "ndard", "tanda", "iger ", "tisch", "wert ", "nach ", " ände", "eschl", "ht ge", "taste", " name", "en in", "nung "},
+		"en":      {"tion ", " the ", " not ", " for ", " file", "ction", "ation", "file ", " with", "valid", "alid ", " and ", "able ", "with ", " inva", "inval", "nvali", "ectio", " comm", "ions ", "ption", "secti", " sect", " opti", "ting ", "optio", " erro", "error", "tions", " inst", "rror ", "s not", "annot", " name", " cann", "canno", "nnot ", "d to ", "catio", "sion ", " use ", "name ", " fail", "iled ", "e to ", " regi", "ed to", "symbo", "ymbol", " symb", "sing ", "ning ", "ailed", "faile", "egist", "giste", "ister", "regis", "direc", "irect", "reloc", " relo", " this", "this ", "ment ", "cted ", " git ", "struc", "truct", "ected", " spec", " sign", "nstru", "speci", "locat", "ster ", "instr", "ructi", "uctio", " dire", " line", "t be ", "pport", "value", " valu", "tory ", "suppo", "uppor", " warn", " from", "from ", "opera", "e for", " oper", " is n", "comma", "forma", "mbol ", "pecif", "only ", "ould ", "line ", "ormat", "rning", " only", "ocati", " supp", "arnin", "warni", "expec", "xpect", "is no", "t of ", " cont", "not s", "not a", " inte", "the s", "ange ", "e of ", "ector", "rted ", "alue ", " key ", "ing t", "numbe", " numb", "umber", " pack", "mmand", "omman", "bject", " set ", " type", "e the", " are ", "eloca", "objec", " can ", "orted", "type ", "code ", " obje", "nable", "mber ", " remo", "ion i", "peran", " size", "ding ", "d not", "pecte", "erand", "size ", "porte", " read", "ated ", " outp", "outpu", "utput", " inde", "recto", "n the", "tput ", "inter", "ring ", "t the", "fault", "known", "nown ", "tive ", " must", "must ", " requ", " defa", "creat", "defau", "efaul", " coul", "could", "files", "chang", "ecifi", " you ", "index", " crea", "commi", "ommit", " bran", "ssing", " chan", "branc", "ranch", "iles ", "ument", "le to", "nknow", " unkn", "unkno", "ed in", " form", " expe", " of t", "t to ", " comp", "hange", "reate", " argu", "argum", "ault ", "gumen", "rgume", "d in ", "f the", "es no", "ld no", " list", "ture ", "uld n", "used ", "ble t", "ctory", "gener", " gene", "requi", "led t", " conf", "press", "quire", "ignor", " in t", " igno", "enera", "king ", "port ", "table", "signa", "s to ", "equir", "missi", "rand ", "mand ", " does", "ndex ", "e is ", "in th", "ject ", " mode", " to s", " used", "rsion", "s in ", " stat", "versi", "d for", "ersio"},
+		"es":      {"ción ", "o de ", " para", "para ", "o se ", " no s", "no se", "s de ", "ación", "a de ", " se p", "se pu", " pued", "puede", "ión d", "ando ", "cción", " con ", "ón de", " del ", "uede ", " de l", "válid", " de c", "n de ", "ones ", " que ", "e pue", " una ", "iones", "ento ", " los ", "ente ", " no e", "cione", "ifica", "r el ", "es de", "direc", " fich", " de s", "chero", "fiche", "icher", " espe", "e la ", "ecció", "sión ", "nombr", " dire", "e de ", "lida ", "no es", "do de", " está", " opci", " nomb", " esta", "ombre", " de e", "secci", " secc", "r de ", " invá", "invál", "nváli", " desc", " arch", " regi", "error", " erro", " por ", "archi", "hero ", "rchiv", "álido", "a la ", "chivo", "or de", "icaci", "n el ", "de la", " en e", "opera", "o es ", "egist", "regis", " cont", "lido ", " de r", "a el ", "gistr", " oper", " las ", "rror ", "mento", " fall", "e con", "cació", "ciona", "os de", "de co", "está ", "istro", "forma", " en l", "mbre ", "mient", "ro de", " inst", "irect", "de re", "to de", " comp", "ccion", "o par", " git ", " de d", "torio", "ar el", "en el", "o en ", "nstru", " entr", "ubica", " obje", "entra", "ados ", "clave", " se e", "recto", " inte", "contr", "iento", "struc", "objet", "rando", "conoc", "n la ", "mite ", "ntrad", "pción", "rada ", "espec", "línea", "rucci", "tado ", "trucc", " líne", "s en ", "stro ", " valo", "esper", "valor", "instr", " clav", "cado ", "spera", "encia", "icado", " conf", "en la", "o no ", "orio ", "hivo ", " reub", "eubic", "reubi", "e el ", " sin ", " símb", "mbolo", "símbo", "ímbol", "opció", "trada", " de p", " el c", "amien", " como", "ador ", "aliza", "como ", " de t", "escri", "mente", " de f", "o con", "ector", "ra de", "erand", " de a", "r la ", "peran", "alida", "lave ", "speci", "a con", "onoci", " debe", "ficad", "nocid", " ser ", "bicac", "álida", "rado ", " la s", "tiene", "ctori", "o est", " comm", " crea", "alor ", "bjeto", "actua", " váli", "ctual", "pudo ", " pudo", " actu", "a un ", "cambi", "ntes ", "a en ", "de se", " el s", "e pud", " sali", " tipo", " form", "adas ", "ante ", "e esp", "commi", "ommit", " la c", "iene ", "ontra", " de u", " núme", "eccio", "númer", "úmero", "ecifi", "pecif", "ara e", "inter", "utili", " camb", "confi", "l de ", "mero ", "ínea ", "ctiva", "trar ", "cific"},
+		"fr":      {"tion ", "e de ", " les ", " pas ", " est ", "ation", " pour", "pour ", " de l", "ment ", "ction", "s de ", " des ", "ichie", "chier", "fichi", " fich", " dans", "dans ", "er le", "ible ", "tilis", "utili", "ion d", "ement", " util", " de c", "ble d", " une ", " comm", "sible", " impo", "le de", "ssibl", "ossib", "possi", "hier ", "impos", "mposs", "ions ", "r les", "r le ", "e la ", "n de ", " de s", "t pas", "tions", "on de", "sion ", " non ", "ans l", " avec", "avec ", "ique ", " par ", "de la", "ption", "r de ", "r la ", " opti", "être ", " de r", "n est", " être", "valid", "optio", "ectio", "eur d", " ne p", "t de ", " supp", "e fic", "our l", "ande ", "e pou", "alide", "est p", "de co", " n es", "egist", "regis", "ilise", "gistr", " inco", " erre", "rreur", "erreu", "istre", "atten", "affic", "ffich", " affi", "lide ", "e des", "ligne", "ficat", "ifica", "ture ", "reur ", "ur de", "e con", "re de", "st pa", " inst", " la s", " la c", "iser ", "e com", " de d", "inval", "nvali", " inva", "corre", "secti", " atte", " regi", "fiche", " lign", " sect", "truct", "es de", "struc", " de t", "nstru", " nom ", "dress", "uctio", "catio", "e pas", " le c", "teur ", "de l ", "t êtr", "cher ", "adres", " entr", " sign", "ructi", " de f", "sage ", " sur ", "able ", " de p", "ssage", "symbo", "ymbol", " le f", " peut", "peut ", "liser", " cont", " symb", "leur ", " vale", "essag", " git ", "aleur", "valeu", "s le ", "aire ", "instr", "er la", "pas d", " comp", "mande", "ur le", "ent d", "oire ", "forma", "icati", " que ", "code ", "tique", " opér", "omman", "ormat", "icher", "mmand", " inte", "comma", "opéra", "connu", "e dan", "lisat", "r un ", " vers", "ns le", "ilisa", "inter", "e peu", "le d ", "er de", "toire", "er un", "stre ", "tendu", "ne pe", "rande", " le s", "e du ", "nt de", "comme", "e le ", "ttend", " répe", "ertoi", "perto", "rtoir", "réper", "épert", "de re", "igne ", " spéc", "s la ", "spéci", "mbole", "iers ", " corr", "s les", "te de", "aquet", "lique", "paque", "de de", "ntrée", " conf", " l in", "le fi", "iste ", " éche", "ssion", " clef", "entré", " code", "pport", "press", "défin", "hiers", "éfini", "ateur", "omme ", " d un", "chec ", "péran", "suppo", " la l", "age d", " de m", " inde", " obje", "essio", "échec", "ignor", "faut ", "index", "ressa"},
+		"gl":      {" non ", "ción ", "a de ", "ación", "o de ", " para", "para ", " de s", "unha ", "fiche", " fich", "cheir", "heiro", "ichei", "s de ", "eiro ", "non s", " unha", "on se", "ando ", " tecl", "tecla", " de c", "cació", " que ", " esta", "lica ", "blica", "e de ", "públi", "úblic", "íbel ", "icaci", "de si", "n se ", "posíb", "osíbe", " posí", "aquet", "paque", "ión d", "síbel", " do s", " paqu", " repú", "epúbl", "repúb", "este ", "non é", "on é ", " con ", "quete", "ica d", " está", "sión ", " sign", "ingua", "lingu", "ngua ", "ario ", " ling", " requ", "chave", "e sig", " chav", "signo", "gnos ", "gua d", "ignos", "ua de", "ións ", "os de", " nome", "ro de", " erro", "ca de", "equír", "quíre", "requí", " pode", " se p", "rese ", "ión p", "n par", "uíres", "írese", "r de ", "válid", "mento", " váli", "siste", "suari", " cont", " sist", "e un ", "ifica", "n de ", "pción", "recto", "uario", "usuar", " usua", "está ", "istem", "stema", "tema ", " de e", "do no", "ente ", " opci", " aute", " fall", "ar o ", "auten", "entic", "ntica", "tenti", "utent", " por ", "opció", "ón pa", " é po", "orte ", "cións", "é pos", " comp", "ese a", "ticac", "clas ", "eclas", "fallo", "se un", " de d", "de co", "e aut", "erro ", "ón de", " inst", "n é p", "teco ", "se au", " sen ", "have ", "o sis", "pode ", " nort", "e con", "norte", "entra", "nome ", "non v", "a cha", "etes ", " foi ", " sina", "ciona", " orde", "esión", "uetes", " de a", "ento ", "n vál", "o non", " de m", "iano ", "o do ", "on vá", "or de", " bloq", "a do ", "o fic", "tado ", "torio", " de p", "tura ", " desc", "encia", "insta", "aliza", "direc", "do de", "ional", "nstal", " inte", "escri", "onal ", " sur ", "non f", "corre", " dire", " espe", " segu", "e non", "ector", "inter", " nive", "ivel ", "nivel", "o nor", " de f", " do n", " ingl", " liña", "a est", "ados ", "do su", "ecla ", "glés ", "inglé", "irect", "nglés", " conf", " entr", " idio", "ar a ", "atura", "dioma", "eiros", "idiom", "iros ", "on fo", "rada ", "stala", "l de ", "las m", "n foi", "use u", "espec", "maiús", "actua", "ado d", "ctual", "trada", "ument", " como", " mort", "ctori", "natur", "orrec", "rrect", " prod", "inatu", "produ", "r un ", "sinat", " actu", "as mo", "estab", "morta", "o sur", " de l", " espa", " fran", " maiú", "ar un", "franc"},
+		"hr":      {"atote", "datot", " dato", "totek", " nije", "nije ", "anje ", "opcij", "cija ", " opci", " znak", " ili ", " ako ", "nski ", "moguć", " broj", "oteka", " kao ", "nared", " nare", "aredb", " mogu", "teka ", "torij", "valja", "aljan", "cije ", " ispi", "direk", "irekt", " dire", "pcija", "oteke", "teke ", "ektor", "ktori", "rekto", "jski ", "oguće", "a je ", " neva", "evalj", "neval", " post", "o je ", " kori", "a za ", "e mog", " tipk", "anja ", "guće ", "je mo", "koris", "uspje", " argu", "argum", "gumen", "rgume", "broj ", "ostav", "anski", "znako", "ije m", " izla", "izlaz", "ument", " jezi", "piše ", "nakov", "posta", " uspj", "jedno", "vrije", " vrij", "i jez", "otreb", "potre", " ulaz", " ime ", "grešk", " samo", "kovni", "ispiš", "vrši ", "završ", "spiše", "ustav", " greš", " zavr", "ko je", "samo ", "i zna", " svak", "ako j", "e dat", " isto", "forma", "ije u", "je pr", "a dat", " potr", " spec", "speci", " nave", "lika ", "e usp", " nema", "e pro", " stan", "ijski", "veden", " je o", " retk", "stavi", "vanje", "na je", "naved", "orij ", "ovjer", "avede", "nema ", "rski ", "je us", "redak", "znak ", " zada", " za p", " proc", " reda", "proce", "roces", "enje ", "zadan", " imen", " sust", "susta", "ijedn", " polj", "avrši", "ecifi", "mena ", "pecif", " repu", "andar", "nica ", "publi", "repub", "epubl", "ublik", "blika", "ndard", "stand", "tanda", "a pri", "arija", "ednos", "spjel", "je na", "rijed", " izra", " prij", "trebn", "a se ", "dnost", "reška", " koji", "i se ", "pcije", "dardn", "eška ", "iranj", "ment ", "varij", " vari", "ljani", " prom", "jani ", "lski ", " kont", "je po", "redbe", "rijab", "ijabl", "tski ", "vjera", "e za ", "ovni ", "renut", "a na ", "e se ", "orist", " form", " može", " sign", "biti ", "koji ", "ra za", "ebna ", "ignal", "rija ", "signa", "e na ", "mjest", "jera ", "mijen", "ni je", "pski ", "rebna", "jelo ", " veli", "e pre", "ormat", " ljus", "je ov", "je za", "ključ", "ranje", "remen", " izvr", " ovje", "bna j", "era z", "izvrš", "o kao", "orisn", "pjelo", " rabi", " stri", "akovn", "jana ", "izraz", "može ", " simb", "avanj", "ciju ", "e ovj", "imbol", "ličin", "rši s", "simbo", " uklo", "jeni ", "strin", "tring", "uklon", " tren", "i bro", "ljana", "risti", " bajt", " bez ", "adano", "avlja", "sto k", "trenu", "zlaz "},
+		"hu":      {" nem ", " fájl", "haszn", "aszná", "sznál", "kapcs", "apcso", "pcsol", " para", "aranc", "paran", " kapc", "rancs", " hasz", "csoló", "telen", "elen ", " vagy", "vagy ", "állít", "tása ", "rvény", " érvé", "érvén", "menet", "fájl ", "lítás", "ható ", " az a", "vényt", " egy ", "ytele", "elmez", "nytel", "ényte", "karak", "akter", "arakt", "rakte", " kara", " szám", "znála", "nálat", "eállí", "rtelm", "értel", "telme", " ninc", "nincs", "llítá", " a z ", "beáll", " rend", "ítása", "könyv", "nyvtá", "yvtár", "önyvt", " beál", "sítés", " kiír", "csoma", "somag", " lehe", "a meg", "lehet", "megad", " csom", " mega", " mint", "dszer", " mind", "endsz", "ndsze", "rends", "jelen", "issza", "llent", "vissz", " az e", "séges", " meg ", "érték", "bille", "entyű", "illen", "lenty", " a sz", " hely", " köny", "éges ", "formá", "rása ", "incs ", "soló ", " bill", " hiba", " viss", "ument", "a fáj", "mentu", "ítés ", "entum", "követ", " szük", "ikus ", "szüks", "züksé", "ükség", "argum", "gumen", "rgume", " a ka", " a kö", " köve", "válto", " argu", "áltoz", "írása", "mint ", " a fá", "tése ", " sike", "siker", "ehet ", "ksége", "iírás", "kiírá", "nem t", "össze", " szab", "felha", "minde", " felh", " vált", "ancs ", "figye", "igyel", "nem l", " figy", "imene", "kimen", "kulcs", " ha a", "csak ", "ális ", " érté", "bemen", "elhas", "lhasz", " beme", " szig", "emene", "hiba ", " kulc", " össz", " kilé", "kilép", " csak", "a az ", "inden", "ása a", "ználó", " kime", "nden ", "válas", "esíté", " a me", " alap", " megh", "nyelv", "tott ", " a pa", "helye", "lépés", "teles", "álasz", " szin", "a par", "aság ", "ként ", " a ki", "em le", "köztá", "rsasá", "saság", "társa", "zett ", "ztárs", "ársas", "öztár", "nyos ", "ányos", " közt", "az al", "olvas", "laszt", "ának ", "ilépé", "és sz", "etkez", "itele", "vetke", "övetk", " hite", "enet ", "ezett", "hitel", "tkező", "elesí", "lesít", "k meg", " egye", " van ", "a köv", "fejez", "s szü", "lható", "szám ", "m leh", "szint", "az el", "álhat", " jele", " nyel", " tart", "kerül", "elyet", "ja a ", "s meg", "ha a ", "létre", "álata", "a kap", "abván", "bvány", "hető ", "ntyűk", "szabv", "tés s", "ványo", "zabvá", "folya", "okat ", "olyam", "ítése", " adat", "gyelm", "megje", "yelme", " megj", "elvek", "yelve", " form", " létr", "lyett"},
+		"id":      {"tidak", " tida", "idak ", "dak d", "ngan ", "dapat", "ntuk ", " untu", "untuk", " dapa", "apat ", "yang ", " yang", "ak da", "k dap", "at me", "pat m", " meng", " berk", "berka", "erkas", "rkas ", "akan ", " git ", "ukan ", "ikan ", " dari", "engan", "denga", " deng", "dari ", "t men", "anda ", " dan ", " peng", "nakan", "gunak", "unaka", "dalam", "alam ", "ang d", "gagal", "komit", " dala", "omit ", " gaga", "agal ", "ng di", "kan d", " anda", "tuk m", "tkan ", " komi", "uk me", "pada ", "tori ", " ada ", "k men", "ak di", " memb", " ini ", " atau", "an pe", "valid", " vali", "atau ", "kan s", "alid ", "kan p", " pada", "pengg", " sebu", " obje", "nama ", "an di", "angan", " nama", "an se", "bjek ", "hkan ", "bang ", "objek", "ikasi", "k val", "buah ", " caba", "abang", "caban", "ebuah", "sebua", " peri", "lang ", "kan u", "ak va", "kan k", "ahan ", "dak v", "n unt", "ohon ", "atkan", " guna", "dak a", " inde", "an un", "atan ", "t mem", "perin", "k ada", "al me", "nggun", "gal m", "abung", "direk", "gabun", "ak ad", "enggu", "gguna", " dipe", " dire", "hapus", "ensi ", "engga", "anya ", "aris ", "irekt", "an ke", "aikan", "indek", "ndeks", "deks ", "diper", "baris", "ektor", "rekto", " bari", "ktori", "buat ", "kasi ", "pkan ", "ulang", "apkan", "feren", "efere", "refer", "apus ", "erens", "kan b", "rensi", "an da", "ungan", " ulan", "at di", "rapka", " tak ", "pohon", "tamba", " poho", "masuk", " refe", " hany", "hanya", "perlu", " meny", "ingka", " jalu", "an ko", " menu", "jalur", "alur ", "pilih", "lihat", "a ber", "kerja", "k ter", " tamb", "nkan ", " remo", "erja ", " konf", "ang t", " masu", "an be", " perl", "ang b", "n ber", "ahkan", "alan ", "angka", "lkan ", " pili", "eluar", "ggabu", "nggab", "tanda", "emote", "remot", " buka", "kelua", "membu", " baha", " kelu", "bagai", "modul", " seba", "forma", "mote ", "bukan", "hasa ", "ahasa", "alah ", "bahas", "rkan ", "unci ", " kerj", " repo", "berik", "l men", "iperl", "posit", " opsi", " subm", "bmodu", "submo", "ubmod", "eruba", "kunci", "rubah", "n seb", "ak te", "mengg", "sebag", "ntah ", "itori", "mengh", "sikan", " terl", "ambah", "an me", "ebaga", "erint", "jalan", "oleh ", " mena", "agai ", "bahan", "eposi", "osito", "repos", "sitor", " nila", "intah", "nilai", "rinta", "erluk", "lukan", "rluka"},
+		"it":      {" non ", "ione ", "zione", " per ", "bile ", " impo", "azion", "impos", "ibile", " dell", "sibil", "file ", "possi", "ssibi", "ossib", " file", " del ", "mposs", "ente ", "e di ", "o di ", "ifica", "one d", "e il ", "e del", "e non", "ella ", "ento ", " con ", "mento", "ioni ", "valid", " vali", "enti ", "esegu", " git ", " eseg", "chiav", " chia", "tato ", "non r", "ament", "a di ", "on ri", "re il", "aggio", "a del", " dei ", "uscit", "segui", "non v", "ficat", " cont", " opzi", "opzio", "pzion", " stat", "on è ", "non è", "o del", "zioni", "della", "sione", "n val", " comm", "hiave", "iave ", "on va", "ando ", "ne de", "lizza", "e la ", "commi", "mmit ", "ommit", " rius", "l fil", "tory ", " una ", " di c", " ques", "quest", "iusci", "riusc", "error", " erro", "o non", " spec", "n riu", " aggi", " inte", "are l", "cato ", "icato", "posta", "speci", "are i", "rore ", "rrore", "dell ", " corr", "cita ", "cific", "ecifi", "pecif", "stato", " il c", " indi", " di s", "etto ", "esto ", "omand", "scita", "nale ", "alido", "lido ", "coman", "dific", "odifi", "sono ", "ante ", "porta", " coma", "i di ", "e un ", "inter", " esse", " nome", "utent", " che ", "esser", "sere ", "ssere", "mente", "siste", " nell", "o per", "ore d", "a chi", "forma", "e per", "nome ", "tale ", "i com", " modi", "alizz", " rich", " dire", "richi", "modif", "mpost", "ichie", "ne di", " atte", " conf", " sono", "ile d", "e con", "ero d", "non s", "direc", "irect", "ector", "razio", "re un", " crea", "ctory", "recto", " come", " firm", "o sta", "come ", "ggett", "ogget", " il f", "ional", "i fil", "uire ", " ogge", "ggio ", " bran", "eguir", "menti", "branc", "ranch", "anch ", "i con", "care ", "tura ", " nel ", "guire", "per l", " sott", "l com", "enza ", "etti ", "ile a", "sotto", " comp", "a un ", "elle ", "iment", "ental", "il co", "le di", "o dei", "ro di", "to di", "ispon", "lingu", " il p", "esta ", "ggior", " usa ", "tente", "dice ", "giorn", " di u", " dura", " ling", "a il ", "di co", "mando", "merge", "onale", "orso ", "corso", "lità ", "tore ", "a per", "ato d", "duran", " solo", "indic", "re la", "solo ", " di l", " merg", "rante", "firma", "urant", "chett", "contr", "per i", "ntale", "delle", "rimen", "tare ", " segn", "lo st", "tazio", "o con", " ness", "essun", "nessu", "ile c"},
+		"nl":      {" van ", "stand", " niet", "niet ", "besta", " een ", "estan", " best", " het ", " voor", " word", "bruik", " als ", "n de ", "ebrui", "gebru", "voor ", " gebr", "tand ", "eren ", "n van", " met ", "en va", "optie", "geven", "even ", " opti", " kan ", "teken", "geen ", " geen", "egeve", "isch ", "gegev", "an de", "dige ", "nden ", "regel", " onge", "ordt ", "rden ", "wordt", "eldig", "geldi", " waar", " gege", "van d", "ongel", "naam ", "ngeld", "orden", "atie ", "ptie ", "elijk", "aarde", "onder", "pakke", "n nie", "onen ", "ruike", "waard", "worde", "akket", " rege", " stan", "andaa", "tanda", "daard", "ndaar", "naar ", " teke", " zijn", "zijn ", "arde ", " tone", "tonen", " verw", "eerd ", " alle", " pakk", "e geb", "ument", " over", "eken ", "voer ", "itvoe", "tvoer", "uitvo", "t de ", "en in", "ende ", "argum", "gumen", "rgume", "n bes", " naar", "e is ", " argu", "en ge", "ngen ", "en be", "ties ", "n het", "racht", " bij ", "ldige", "sche ", "allee", "drach", "erwij", "llen ", "opdra", "pdrac", "alen ", " aan ", "n wor", " dit ", "en op", "ische", "toets", " vere", "ereis", "verei", "reist", "acht ", "anden", "ingen", " opdr", "laats", " uitv", "n in ", "e van", "verwi", " map ", " door", " toet", "e ver", "t een", " naam", "n een", "achte", " inst", " deze", "egel ", "ment ", "syste", "t ver", "tands", "tisch", " die ", " dan ", " is v", "lijk ", "ruik ", "s ver", "deze ", "n is ", " de g", "de ge", "de op", "eling", "et ge", "ijder", "rwijd", "sluit", "van e", "wijde", "t nie", "e bes", "gels ", "invoe", "nder ", "n met", "pties", "aken ", "ander", "nvoer", "tande", "door ", "fout ", "nieuw", "stell", "ellen", "tten ", "en al", "iet o", "proce", "iken ", " de o", "en to", "roces", "en me", "varia", " onde", " uit ", "antal", "uiken", "en vo", "n en ", "publi", "r het", "s van", "telle", "eerde", "steem", "ystee", "aanta", "tie i", "an ni", "lleen", "leen ", "t voo", "an he", "deren", "eist ", " bron", " de v", "aard ", "bliek", "e opt", "n ver", "ntal ", "ublie", "en en", "is ve", "epubl", "estaa", "liek ", "repub", " de s", "ariab", "erde ", "ling ", " syst", "e reg", "ekend", "lijke", "et ve", "inste", " aant", " fout", " cont", " vers", "abele", "afslu", "fslui", "r de ", "erste", "iabel", "maken", "n voo", "riabe", "t aan", " afsl", "e geg", "en na"},
+		"pl":      {" nie ", "anie ", " plik", "nie m", "ie mo", " jest", "e moż", "jest ", " możn", "można", "ożna ", "enie ", " prze", "ania ", "nych ", " się ", "enia ", "nie p", "owani", " opcj", " nazw", "nego ", "wanie", "klucz", "pliku", "liku ", " przy", " kluc", "ie po", "zapis", " zapi", " pole", "nie z", " dla ", " poda", "e jes", "polec", "olece", "ński ", " git ", "stawi", "ości ", " lub ", "zenie", "tworz", "wania", "forma", "sekcj", " znak", " sekc", "podan", " niep", " usta", "atalo", "katal", "eceni", "lecen", " kata", "nie j", "talog", "ie je", "ustaw", "zosta", " błąd", "błąd ", " błęd", "prawi", "warto", "artoś", "yfika", "anych", "nie w", "plik ", "jski ", "wypis", " wypi", "acji ", "nie u", "o się", " stan", " wyjś", "wyjśc", "yjści", " zost", "ument", "ło si", "rawid", "rowan", "awidł", "idłow", "widło", " użyt", " zmie", " brak", "ylko ", " tylk", "tylko", "czeni", "argum", "gumen", "rgume", "zmien", " lini", "żna u", "pisan", " podp", " argu", " obie", "ików ", "ować ", " licz", "ienie", "obiek", "wane ", "odpis", "podpi", "wego ", "biekt", "owego", "ywani", " wart", "niepr", "lucza", "wany ", "błędn", "kcji ", " powi", "przez", "rzez ", " zmia", "tyfik", "zmian", "epraw", "iepra", " aby ", "fikat", "pisu ", "prawd", "ego p", "owane", " wyko", "nie n", "liczb", " inde", " jako", "mieni", "lików", "plikó", "opcja", "jako ", "wykon", " niez", " bez ", " może", "owany", "pcja ", " jeśl", "eśli ", "jeśli", " doda", "indek", "ndeks", "nika ", "rzeni", " być ", "e pow", "worzy", "ienia", "e nie", "nie s", "nieni", "rzyć ", "a prz", "brak ", "kcja ", "użytk", "cenia", " odcz", "e pod", "ejści", "nazwa", "kiwan", "orzyć", "ostał", "zenia", "ormac", " usun", " wyma", "odczy", "wymag", "nazwy", "ymaga", " wejś", "czyta", "kowni", "azwy ", "dczyt", "ytkow", "żytko", "inii ", "linii", "tkown", " form", "wejśc", "myśln", "ownik", "wietl", "e prz", "isani", "świet", "omyśl", " praw", "towan", "aktua", "ański", "ktual", "ogram", "progr", " symb", " wyśw", "domyś", "symbo", "wyświ", "ymbol", "yświe", " wszy", "wszys", " domy", "rogra", "szyst", "zystk", "est p", "ypisa", "lucz ", "tość ", "nie d", "syste", "ystem", "ekcja", "rtość", " paki", " syst", "yjski", "ało s", "pakie", "ystki", "ściow", "e uda", "znacz", "ie ud", " udał", "dało ", "może ", "udało", " kont", " ście"},
+		"pt":      {"o de ", " para", "para ", " não ", "ação ", "s de ", "ando ", " fich", " com ", "a de ", "cheir", "fiche", "heiro", "ichei", "eiro ", " de s", "ível ", "ções ", " de c", "ente ", " nome", "ifica", " espe", "mento", " que ", "arqui", "ão de", " arqu", " uma ", "o par", "válid", "ção d", "ados ", "defin", "o com", " por ", "rquiv", "quivo", "linha", "invál", "nváli", " invá", "ssíve", "sível", "ossív", "possí", " linh", "nome ", " cont", "forma", " falh", "ento ", "e de ", "espec", "ntrad", "rada ", "es de", "efini", " erro", " de e", " entr", "coman", "speci", "mando", "omand", " coma", " inte", " de a", "trada", "uivo ", "pção ", " ser ", "opção", " opçã", "capaz", "apaz ", "erro ", "aliza", " inca", "incap", "ncapa", " defi", " como", "como ", "ament", " saíd", "aída ", "saída", "entra", " de p", "os de", "ecifi", "pecif", "lido ", "álido", " comp", "ário ", "de co", "cific", "ciona", " pode", "tado ", "inter", " de f", "ro de", " proc", "ument", "ador ", "mente", " git ", " remo", " padr", "de se", " desc", "inha ", " argu", "argum", "rgume", "gumen", "ações", "ição ", "contr", "e com", " impo", "cada ", "o do ", " foi ", "do de", "pacot", " exec", " nenh", "nenhu", "to de", "ão fo", "rado ", " form", "a par", "adrão", "padrã", " esta", "drão ", "execu", "tório", "adas ", "a com", "o inv", " poss", "r de ", "cação", "não f", "enhum", "proce", "ntes ", "cesso", "ficad", " valo", "valor", "ocess", "roces", " sem ", " dire", " segu", "o em ", "acote", " paco", "impos", " núme", "númer", "úmero", " list", "mposs", " de t", "icaçã", "do co", " most", "mostr", " está", "lista", "ostra", " de d", " avis", " conf", "icado", "s com", " de m", " cria", "local", "pode ", "falha", "ão é ", "e ser", "as de", "prime", "aviso", "corre", "eiros", "iros ", "ar o ", "alha ", "alter", "ome d", "ório ", "está ", " de r", " memó", "emóri", "memór", "mória", "xecut", "s par", "o não", "r um ", "viso ", "o foi", "atual", " alte", " atua", "mero ", "ência", "conhe", " tama", "ormat", "onhec", "amanh", "manho", "taman", "da pa", "e fic", " usa ", "esso ", "ões d", "pções", " de u", "opçõe", "or de", "pasta", "remov", " opçõ", " past", "a opç", "o con", "siste", " corr", "alhou", "falho", "izado", "lhou ", "de re", "anho ", "mbolo", "ontra", " símb", "porta", "símbo", "ímbol", "e con"},
+		"ro":      {"este ", " este", "area ", " pent", "entru", "ntru ", "pentr", "ează ", "e de ", "ului ", "oare ", "țiune", "fișie", "ișier", "care ", " fiși", " nume", "opțiu", "pțiun", " opți", " nu s", "utili", "tiliz", " util", "oate ", "iunea", "unea ", "eroar", "roare", " eroa", "ifica", "valid", "a de ", "iliza", " sau ", " inte", "l de ", "cțiun", "inter", " poat", "poate", " chei", " de s", " nu e", "ment ", "i de ", "țiuni", "ul de", "iune ", "ă de ", " se p", "u est", "nu se", "u se ", "se po", " de c", "nu es", " neva", "evali", "neval", "nume ", "tru a", "lizat", " de i", " real", "ocare", " afiș", " simb", "imbol", "simbo", "ecțiu", " secț", "secți", " semn", "ește ", "proce", "carea", "roces", " valo", " proc", "are d", " dacă", "dacă ", " ieși", "e poa", "aloca", "ru a ", " din ", "ealoc", "ea de", " tast", "realo", "ieșir", " care", "te de", "e la ", "eșire", "iuni ", "verti", "re de", "locar", "direc", "alid ", "șier ", "ierul", "coman", "omand", " sunt", "rebui", " aver", "avert", "tific", " comp", "ntern", " coma", "ertis", "ismen", "rare ", "rtism", "sment", "tisme", "șieru", " de l", "forma", "tare ", "ă pen", "afișe", "e int", "irect", "șează", " intr", " treb", "fișea", "ișeaz", "trebu", "e nec", "ument", " spec", "speci", "e în ", "trare", "ecuno", " fără", "fără ", " dire", " nece", "ator ", "neces", "e să ", "sunt ", "a est", "siune", " numă", "le de", "număr", "rului", "icare", "loare", " argu", "argum", "gumen", "rgume", " inst", "rea d", "aloar", "ficar", "valoa", " lini", "tarea", "buie ", "publi", "ublic", "cesar", "ebuie", "ă fie", "cunos", "egist", "entif", "noscu", "ntifi", "oscut", "regis", "ste n", "unosc", "șire ", "gistr", " fie ", " list", " să f", "e num", "cific", "folos", "e com", "rile ", "te ne", "ecifi", "eptat", "pecif", "rul d", "stare", " folo", "alidă", "erul ", "izato", "lidă ", "zator", "comen", " de a", " de d", " fost", "are n", "ecesa", "fost ", " de r", "tate ", " star", "cheia", "t de ", "heia ", "ie să", " exec", "ficat", "r de ", " într", "izare", " come", " nu a", "activ", "menzi", "omenz", "să fi", " de p", " nu p", " s a ", "achet", "execu", "pache", "torul", "irea ", " citi", "are i", "de re", "lica ", "re in", "shell", " aces", " de f", " shel", "acest", "taste", "uie s", "e un ", "mele ", "accep", "auten", "ccept"},
+		"ru":      {"ение ", " для ", "ется ", " файл", "польз", "ения ", " пере", "вать ", "испол", " испо", "споль", " удал", "овать", " не у", "льзов", "ользо", "ьзова", "ская ", "ирова", "имвол", "симво", " симв", " ошиб", "е уда", "не уд", "ошибк", "лось ", "алось", "ного ", "далос", " пара", "ается", " пред", "удало", "араме", "аметр", "парам", "рамет", "зоват", " стро", "шибка", "строк", " или ", "ибка ", "значе", "начен", "опера", "ачени", "гистр", "егист", "регис", "уется", " опер", " реги", "ание ", "перем", " ключ", "можно", "ереме", "мещен", "ещени", "струк", " указ", "нстру", "аздел", "разде", "нный ", " разд", "ожно ", "инстр", "рукци", "трукц", "чение", "держи", " инст", "ифика", "возмо", "озмож", "зможн", "ользу", "ский ", " знач", "рован", " не п", "тный ", "указа", "овани", "файл ", "тельн", "лько ", "олько", "тифик", "коман", "оманд", "пусти", "роват", " при ", " изме", " кома", "файла", "ремещ", "измен", " зада", " нево", "быть ", "еверн", " быть", "евозм", "невоз", "подде", "форма", "оддер", "ддерж", " долж", "емеще", "живае", "ожида", "щение", "невер", " неве", "нных ", "редел", "преде", "допус", "казан", " ката", "катал", "айла ", " неко", "атало", "талог", "созда", " посл", " git ", " если", "если ", "кции ", " созд", "ания ", "после", "перан", "стано", "итель", "нные ", "танов", "фикат", " толь", "корре", "тольк", " как ", "еранд", "оррек", "ррект", "опуст", "ержив", "ржива", "данны", "ректн", "запис", "екорр", "некор", "вание", "ическ", "выпол", "ыполн", " выпо", " подд", " объе", "не по", "влени", "менен", "прежд", "вывод", " выво", "е под", " данн", " ожид", " уста", "ствуе", "твует", "преду", "упреж", "вует ", "дупре", "едупр", "редуп", "дение", " запи", "дать ", "режде", "пакет", " паке", " разм", "прави", " недо", "ельно", "разме", " выра", "ежден", "ации ", "метр ", "азмер", "ждени", "устим", " имя ", "ивает", " обра", "нить ", "ений ", "зован", " ссыл", "укции", "умент", "звест", "извес", "льзуе", " подп", "тству", " неиз", "индек", "ндекс", "опред", "мвол ", "тать ", "одпис", "подпи", "вестн", "еизве", "неизв", "ремен", "вить ", "бъект", "анный", "анных", "змене", " форм", "адрес", "енени", " аргу", "аргум", "гумен", "игнор", "ргуме", "вател", "гнори", "льный", "норир", "файло", "ьный ", " непр", "объек", "равил", " адре", "альны", "ентиф", "нтифи"},
+		"sk":      {"súbor", " súbo", " nie ", "e je ", "ie je", "nie j", "čina ", "enie ", " použ", "platn", " chyb", " možn", " prík", "ožné ", "eplat", "nepla", " nepl", "príka", "ríkaz", " balí", "balík", "možné", " je m", "anie ", "ovať ", " kľúč", "použi", "e mož", "je mo", " aleb", "alebo", "lebo ", "publi", " repu", "epubl", "lika ", "repub", "ublik", "blika", " voľb", " adre", " pre ", "adres", "úbor ", "osti ", "hodno", " sa n", " je p", " ako ", " podp", "užíva", " riad", "dresá", "e sa ", "atný ", "chyba", "hyba ", "latný", "resár", "odnot", "astav", "boru ", "á rep", "úboru", " hodn", "ného ", "ká re", "nosti", "vaný ", "oužit", " syst", "ovaný", " zlyh", "názov", "systé", "vateľ", "ystém", " názo", "nštal", "ovani", "inšta", "oužív", "použí", "uje s", "zlyha", " nepo", "rovan", "ívate", "žívat", "vykon", " pri ", " znak", "je sa", "lyhal", " ktor", "ných ", " aktu", "voľba", "nasta", "oľba ", " nast", " vyko", "ázov ", "oznam", "stave", "vanie", "vané ", "ská r", "taven", "výraz", " výra", "formá", " vytv", "ované", " zozn", "a sa ", "zozna", " pred", " shel", "riadk", "shell", " toto", " jazy", "jazyk", "odstr", " odst", "a pri", "nepod", "tvori", "íkaz ", " nedá", "dnota", "je po", "nota ", "sa ne", "á sa ", " zada", "nedá ", "ument", "azyky", "zyky ", "kľúč ", "nčina", "odpis", "podpi", "ácie ", "alova", "talov", "verzi", "vytvo", "ytvor", "štalo", "daril", "odari", "oriť ", "podar", "voriť", "znam ", " nebo", " over", "nebol", "o sa ", " sa z", "epoda", "nenie", "očaká", "stupn", "tina ", "čakáv", " argu", "akáva", "argum", "duje ", "gumen", "lovan", "nie s", "ožnos", "rgume", " byť ", " sa p", " vypí", " zmen", " čísl", "aktua", "ktual", "stup ", "tuali", "ualiz", " verz", " vstu", "aduje", "esár ", "správ", "vstup", "ykona", "žaduj", "žnost", " návr", "alíky", "arilo", "lo sa", "líky ", "návra", "rilo ", "užiti", "ávrat", "jú sa", "strán", "vyžad", "yžadu", "ú sa ", " iba ", " je n", " vyža", "ba pr", "halo ", "itie ", "ktorý", "ratov", "vrato", "yhalo", " sa o", " skup", "dstrá", "nie t", "písať", "á hod", "ísať ", "žitie", " nain", " pros", " sprá", "ainšt", "dá sa", "ento ", "nainš", "remen", "renie", "ácia ", " kont", " sign", " tent", "ilo s", "overe", "skupi", "tento", "veren", " oper", " potr", " sa v", "a pre", "ereni", "kupin", "otreb", "potre", "unkci"},
+		"sl":      {"atote", "datot", " dato", "totek", "čina ", "ščina", "mogoč", "anje ", "ni mo", "porab", " ni m", " mogo", "ogoče", "upora", " upor", "i mog", "goče ", "izbir", " izbi", " ali ", " izpi", "velja", "eljav", " znak", " vrst", "oteke", " štev", "teke ", " neve", "evelj", "nevel", "tevil", "števi", " kot ", " ukaz", " imen", "nega ", "vrsti", "ljavn", "rstic", "itev ", " tipk", "paket", " pake", " napa", " poda", "lika ", " stan", "nska ", " spre", "napak", " name", " pri ", "blika", "zbira", "astav", "e je ", "nasta", "publi", "sprem", "stavi", "ublik", "ument", " repu", "epubl", "osti ", "repub", "bira ", " nast", " pred", "preme", "imeni", "argum", "gumen", "izpiš", "rgume", "e dat", " argu", " ime ", "izpis", " je p", "jska ", "menik", "nosti", " uspe", "določ", "oteka", " dolo", " vhod", "e pre", "stran", " izho", " ni u", "a ni ", "izhod", "vilo ", " pisa", "ključ", "nika ", " izra", "orabi", " pove", "a je ", "povez", "uspel", "znak ", "a dat", "anja ", "redno", "teka ", "evilo", "podan", " brez", "i usp", "ni us", " nave", "brez ", "je pr", "vredn", "oveza", "remen", "avni ", " priv", "andar", "amest", "apaka", "names", "paka ", "stand", "tanda", " polj", " če j", "e na ", "ndard", "privz", "o kot", "oloči", "če je", "a pri", "izraz", "janje", "naved", "dardn", "rivze", "ivzet", "lupin", "ni iz", "ranje", "trani", " lupi", " prek", " sezn", "e pri", "eznam", "i pre", "nost ", "sezna", "o šte", "piši ", "tavit", " samo", " sist", "istem", "stvar", "veden", "velik", "anju ", " pres", " vred", " zakl", "siste", " prev", "e ni ", "ukaz ", " ustv", " vsak", " zapi", "dnost", "oblik", "ustva", " odst", " skup", "avna ", "jščin", "nščin", "vezav", " konč", "avno ", "dstra", "ednos", "javna", "odstr", " obli", " zaht", "ahtev", "zahte", "a za ", "avede", "konča", "a pis", "ločil", "pravi", "to ko", "vanje", " isto", "e upo", "i pod", "ignal", "ična ", "signa", " prip", "isto ", "javno", "je po", "pisav", "znako", "jena ", "ment ", "orabn", "zpiši", "sto k", "ipke ", "isava", "je na", "možno", "sava ", "tipke", " sign", "raba ", "stanj", "stice", "tavlj", "tice ", "vljen", "aven ", "hteva", "rska ", "sklad", " možn", "esto ", "mesto", "oštev", "rabi ", "zadnj", "o ime", "varno", "a tip", "avlje", "oraba", "ožnos", "stavl", "žnost", " izve", " jezi", " nepr", " tren"},
+		"sr-Cyrl": {"атоте", "датот", " дато", "тотек", " кори", " не м", "не мо", "није ", " није", " могу", "справ", "испра", "опциј", "могу ", "корис", " греш", "е мог", " опци", " или ", "грешк", "у да ", "назив", " неис", "неисп", "еиспр", " нази", "гу да", "огу д", " ако ", " број", " одељ", "ешка ", "о је ", "имбол", "симбо", " симб", "орист", " изла", "излаз", "отеке", "ција ", "теке ", "решка", "отека", "тање ", "ристи", " прем", "тека ", " као ", " упоз", "позор", "упозо", "рење ", " испи", "мешта", "озоре", "зорењ", "испис", "ториј", "ције ", " вели", "наред", "орење", "аредб", " наре", "писуј", "исује", "је по", " непо", "пција", "сује ", "еднос", "преме", "дирек", "ирект", "личин", " успе", "емешт", "орију", "ремеш", "велич", "ектор", "еличи", "спису", "правн", " дире", "вања ", "ктори", "ријум", "исти ", "ректо", "је пр", "редно", " аргу", "аргум", "гумен", "ргуме", "аван ", "азив ", "е дат", " знак", "број ", "а је ", "дност", "права", " је п", " улаз", "раван", "а пре", "ештањ", "равна", "умент", " само", "о да ", " поде", " да п", "дељак", "одеља", " бити", "а за ", "е пре", " вред", "бити ", "вредн", "основ", "а се ", "отреб", "потре", " осно", "нски ", "остав", "позна", "твара", "стављ", "време", "е да ", " пост", "вање ", "ељак ", "ости ", "ко је", "ако ј", "епозн", "ност ", "нска ", " може", " свак", "е под", "ознат", "је на", "а дат", " одре", " пода", "лика ", "само ", " посл", " пром", "авна ", " исто", "проме", " наве", " подр", "злазн", " ниса", "исам ", "нисам", "ромен", " нема", "одељк", " чита", "проце", "ности", "тања ", " да с", " редо", "подеш", " извр", "изврш", "ије п", "ствар", " ства", "сновн", " врем", "непоз", " стањ", "може ", "подат", "пције", " врст", "истем", "одржа", "систе", "подрж", "е про", " је д", "спео ", "успео", " сист", "авања", "веден", "е на ", "а про", "више ", " прик", " таст", " прев", "но је", " проц", " стан", "сам у", "теку ", "ам ус", "м усп", "навед", "отеку", "одред", "стање", "је да", "да се", "ео да", "пео д", "прави", "шава ", " садр", "роцес", "садрж", "аведе", "андар", "астер", "мент ", " праз", "држан", " запи", " прек", "ндард", "станд", "танда", "тасте", "а гре", "блика", " шкољ", "е при", " да н", "ешава", " прет", "запис", "штање", "ијум ", " репу", "а под", "авља ", "е за ", "епубл", "ника ", "публи", "репуб"},
+		"sr-Latn": {"nski ", "lika ", " repu", "blika", "epubl", "publi", "repub", "ublik", "anski", "jski ", " jezi", "ezici", "jezic", "zici ", "i jez", "ki je", "rski ", "anje ", "ski j", " ostr", "ostrv", "lski ", " pake", "paket", "tski ", "nija ", "a rep", "ijski", "dski ", "strva", "trva ", " seve", "sever", " južn", "ka re", "eški ", "iški ", "rija ", "ski d", "šajr ", "arski", "evern", " pism", "inski", "ismo ", "pismo", " svet", "nska ", "ndski", " inst", "alski", "insta", "nstal", "ska r", "istoč", "stočn", " isto", "jansk", "mski ", " fran", "zapad", " dola", "a ost", "dolar", "olar ", "sveti", "veti ", " star", "andsk", "anija", "anja ", "apadn", "aški ", "i dol", "ki do", "land ", "stali", "tska ", "vina ", " gorn", "cija ", "gornj", "stan ", " ažur", " zapa", "aketa", "ažuri", "keta ", "oški ", "ska o", "urira", "žurir", " kral", " obla", "alija", "alira", "anska", "blast", "evina", "last ", "nije ", "oblas", "talir", "užni ", "aljev", "iranj", "južni", "kralj", "o pis", "onski", "očni ", "ralje", "ratsk", "stari", "torij", "točni", "vski ", " drža", " kraj", " mora", " nije", "bija ", "držav", "ički ", "ka os", "ki kr", "lija ", "nški ", " dato", " demo", " kata", " sent", "atote", "atska", "datot", "e pak", "erni ", "ijans", "istan", "janje", "jevin", "kraj ", "ljevi", "orija", "riran", "sko p", "strvo", "tanje", "totek", "trvo ", "uški ", "vanje", " gren", "amski", "avlja", "enski", "entra", "franc", "i kra", "ko pi", "lands", "ntral", "sent ", "ski k", "tansk", "tari ", "verni", " doba", " neop", " pokr", " sant", "a obl", "aket ", "ancus", "bavlj", "demok", "dobav", "emokr", "eopho", "kansk", "krats", "mokra", "ncusk", "neoph", "nje p", "nje s", "obavl", "okrat", "ophod", "pokre", "rancu", "traln", " aust", " cent", " pono", " preu", " spis", "ajski", "atski", "austr", "ački ", "centr", "etanj", "i pak", "ička ", "ljanj", "ornja", "ponov", "preuz", "pski ", "retan", "rnja ", "rska ", "ski r", "urski", "vljan", " nave", " nema", " port", "a pak", "avest", "avski", "esti ", "ika s", "južna", "na re", "olski", "onija", "phodn", "ržava", "te na", "užna ", "žava ", " kara", " sred", " teri", "adna ", "anta ", "arija", "avanj", "buri ", "e nav", "edera", "erito", "erski", "feder", "ika k", "itori", "je po", "jedin", "kreta", "lend ", "liran", "manje", "naves", "okret"},
+		"tr":      {" için", " bir ", "için ", " kull", "kulla", "ullan", "dosya", " dosy", "llanı", " işle", " deği", " geçe", "geçer", "leri ", "iyor ", "değiş", "ları ", "ıyor ", "rsiz ", " ile ", " hata", "ersiz", "eçers", "çersi", "madı ", "işlem", "amadı", "osya ", " seçe", " git ", "eçene", "seçen", " veya", "veya ", "eklen", "inde ", " dizi", "arak ", "belir", " beli", "satır", "lanıl", " yeni", " çalı", "çalış", "ların", "larak", " olma", " satı", "eyen ", "ında ", " anah", "ahtar", "anaht", "nahta", "ılama", " olar", "mıyor", "olara", "miyor", " yok ", "meyen", "landı", " veri", "komut", "elirt", " komu", "dizin", "bilir", "tanım", "aları", " yapı", "lerin", "leşti", "leme ", "eştir", "amıyo", "değil", "ilir ", " gere", "k içi", "gerek", "nmeye", " birl", "çenek", " oluş", "değer", " pake", "paket", "ıştır", " sayı", "eğişi", " nesn", "nesne", " semb", "embol", "sembo", "lama ", "anıml", " dili", "eğil ", "lamad", "osyas", "şleme", " değe", "ması ", "dili ", "indek", "luştu", "oluşt", "iştir", "yapıl", "yazma", "syası", "ştiri", " yazm", "çıktı", " çıkt", "uştur", "andır", "girdi", "n bir", " gird", "arını", "abili", "eğişt", "ğişti", " ayar", " bekl", "ayan ", " kald", "namad", " dest", "deste", " inde", "bir d", "kaldı", "ndan ", "hata ", "aldır", " başa", "sayıl", "başar", "lendi", "lirti", "aşarı", "tirme", "ıldı ", "birle", "irleş", "anıla", "htar ", "izin ", "bekle", "tiril", "kleri", "estek", "ulama", "i bir", "klenm", " list", "iden ", "stekl", "ızca ", " bölü", " bağl", " yaln", "alnız", "yalnı", "ayarl", "medi ", "rine ", "tekle", " göst", "göste", "öster", "rleşt", " kara", "erini", "lenme", "yarla", "apıla", "hatal", "liste", "lenen", " konu", "ekler", "erine", " bili", " düze", "içind", "bilin", "dırma", "yenid", " argü", " doğr", "doğru", "enide", "güman", "niden", "rgüma", "çinde", "argüm", "yalar", "ndeks", "osyal", " yazı", "dırıl", "rını ", " bulu", " kiml", " parç", "ilinm", "kimli", "parça", "arısı", "konum", "mak i", "rısız", "şarıs", "syala", "bulun", "yası ", "talı ", "ştirm", " uyar", "anın ", "inin ", " başv", "başvu", "eçerl", "işlen", "lnızc", "nızca", "çerli", "aşvur", "rini ", "ısız ", "labil", "bölüm", "bilgi", "erli ", "bağla", " sonr", "karak", "sonra", "akter", "arakt", "rakte", "ımlı ", "şvuru", " duru", "nekle", "eğer ", "atalı", "ırıla"},
+		"uk":      {"ення ", "ання ", "ська ", " пере", " вико", "корис", "орист", " для ", "вання", " не в", "вати ", "викор", "икори", " файл", "значе", "начен", " поми", "помил", "уванн", "омилк", "увати", "чення", "корек", "орект", "ректн", "озділ", "розді", " розд", "ного ", "аченн", "имвол", "симво", " симв", "перед", "вдало", " вказ", "лося ", " пара", "алося", " неко", "екоре", "некор", "е вда", "не вд", " вдал", "далос", "араме", "парам", "ться ", "аметр", "рамет", "вказа", "милка", "илка ", "ристо", "стову", "истов", "бути ", "казан", " регі", " або ", " бути", " назв", "риста", " знач", "ження", "гістр", "егіст", "регіс", "опера", "изнач", "стано", " опер", "струк", "нстру", "тний ", "трукц", "рукці", "інстр", "истан", "овува", " інст", " кома", "створ", " ство", " запи", "суван", " ключ", "ектни", "коман", "ється", "оманд", "опере", " попе", "попер", " якщо", "якщо ", "вуват", "йська", "нська", "запис", "тання", "товув", "відом", "азано", "ктний", "станн", "есува", "ресув", " має ", "файл ", "зано ", "ифіка", "ння п", "перес", " не м", "аних ", "повід", " вста", "ість ", "танов", "ересу", "ередж", "рити ", " не п", "визна", "встан", " розм", " рядк", "ожна ", "можна", " можн", "розмі", "овий ", "дженн", "аний ", " час ", "ачено", "ійськ", "кції ", "чено ", "дтрим", "перан", "підтр", "файла", "ідтри", "викон", " змін", "еранд", " дани", " неві", "еджен", "озмір", "редже", "аверш", "завер", " виве", "е мож", "форма", "тифік", "фікат", "не мо", "даних", "невід", " заве", "умент", "не ви", "айла ", "аргум", "гумен", "ргуме", " аргу", " форм", "зділ ", "лення", "перев", "адрес", " адре", "е пер", "е зна", "овано", " лише", "лише ", "укції", "евідо", " визн", " підт", "ння д", " ката", " під ", "проце", "ння н", "орити", " типо", "відпо", "дпові", "кільк", "милко", "не пе", "ідпов", "твори", "ворит", "метр ", "анськ", "д час", "під ч", "ід ча", "мвол ", "роцес", "типов", " діап", "апазо", "діапа", "пазон", "іапаз", "катал", " проц", "можли", "ожлив", "атало", "овлен", " кори", "новле", "отрим", "ький ", " код ", "ормат", " отри", "ння з", "вано ", " підп", "жна в", "илков", "талог", " виво", "вивод", "и роз", " приз", "метри", "ний р", "стува", "дані ", " байт", "підпи", "трима", "ідпис", "а вик", "ня по", "ський", " дані", "ристу", "етри ", "на ви", "ти ро", "має б", "тримк"},
+		"vi":      {"hông ", " khôn", "không", "ng th", "ông t", " các ", " thể ", " tin ", " được", "được ", " tập ", "p tin", "tập t", "ập ti", " tiến", "g thể", " cho ", "iếng ", " một ", " tron", "trong", "rong ", " lỗi ", "tiếng", " định", "định ", " của ", "dùng ", " dùng", " với ", " khi ", " chỉ ", " mục ", "chọn ", " đối ", " tên ", "chuyể", "huyển", "uyển ", " chuy", " git ", " chọn", " lại ", " đầu ", " hợp ", "ng ch", " gặp ", "các t", "lệnh ", " lệnh", "ông c", "ông h", " kết ", " tùy ", " vào ", "ường ", "tùy c", "ùy ch", "y chọ", " ghi ", " thư ", "p lỗi", "gặp l", "ặp lỗ", "ượng ", " thay", "thay ", "ng ph", " phải", " đổi ", "phải ", " phần", "phần ", "dòng ", " dòng", "ng kh", " đặt ", " việc", "việc ", " có t", "hợp l", "thư m", "hư mụ", "ư mục", " lần ", "hiệu ", " giao", "giao ", " này ", "ợp lệ", "p lệ ", " hiệu", " thị ", " chưa", " đang", "chưa ", "đang ", " theo", "theo ", "g có ", "ng có", " bạn ", "hành ", "g hợp", " tạo ", "n gia", "ng hợ", "ển gi", "yển g", " dụng", "dụng ", "ng đư", "hiện ", "hiếu ", "ỗi kh", "i khi", "hiển ", " hoặc", "hoặc ", " hiện", "ng tr", "tượng", "ng nh", "lỗi k", " tượn", "đối t", "i tượ", "ối tư", " làm ", "ần ch", "rình ", "trình", " trìn", " nếu ", "ông đ", " cần ", "dạng ", " sai ", "ển th", "n chu", "iển t", " đọc ", " dẫn ", " tìm ", " hiển", " báo ", " dạng", "n thị", "ương ", "cách ", "cảnh ", "i số ", " cách", "lần c", "thành", " thàn", "có th", " cảnh", " kiểu", " tham", "c địn", " sau ", "tham ", " bản ", "kiểu ", "hánh ", " tại ", " nhán", "nhánh", " phân", " qua ", " ký t", "g đượ", "phân ", " chạy", "chạy ", "ký tự", "ý tự ", "ược t", "ến tr", " giá ", " gói ", "iến t", " trị ", "g phả", "ông p", " con ", "hay đ", " thôn", "thông", "giá t", "ảnh b", " chiế", "iá tr", " trợ ", "á trị", " ký h", "ký hi", "ý hiệ", "g dẫn", "ng dẫ", " trên", "phím ", "trên ", " phím", " thực", "thể đ", "thực ", "đối s", " đến ", "y đổi", " liên", "liên ", "ay đổ", " thêm", "m chi", "thêm ", "ối số", " như ", "n trì", "ó thể", " thiế", "mục l", "n kết", " bỏ q", " khác", "bỏ qu", "hiều ", "chiếu", "đường", " bằng", " đườn", "bằng ", " dấu ", "h báo", "nh bá", " xuất", "am ch", "ham c", "xuất ", "khác ", "ỏ qua", " trộn", "một t", "trộn ", " nhận", "nhận ", " máy ", "ác th", "ng ti", "ờng d", " lấy ", "thể t", " hòa ", "n khô", "ạng t"},
+	},
+}
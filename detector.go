@@ -0,0 +1,164 @@
+package getlang
+
+import (
+	"errors"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// InvalidPolicy decides what a Detector does with invalid UTF-8 sequences and
+// control characters
+type InvalidPolicy int
+
+const (
+	// ReplaceInvalid turns each invalid sequence or control character into a word break
+	ReplaceInvalid InvalidPolicy = iota
+	// SkipInvalid drops invalid sequences and control characters
+	SkipInvalid
+	// RejectInvalid fails with ErrInvalidText when the text contains any
+	RejectInvalid
+)
+
+// ErrInvalidText is returned by a Detector using RejectInvalid
+var ErrInvalidText = errors.New("getlang: text contains invalid UTF-8 or control characters")
+
+// Detector detects languages with configurable input handling
+//
+// The zero value is ready to use and behaves like FromString
+type Detector struct {
+	// Invalid decides what happens to invalid UTF-8 and control characters
+	Invalid InvalidPolicy
+	// Form is the Unicode normalization form applied before n-gram extraction;
+	// the profiles are in NFC, and NFKC also folds compatibility characters.
+	// Fullwidth and halfwidth forms are folded under any form
+	Form norm.Form
+	// Clean selects the URLs, handles, numbers, emoji and symbols removed before scoring
+	Clean CleanFlags
+	// TrustDeclared gives the language a document declares a head start, as
+	// long as the text itself gives that language some points
+	TrustDeclared bool
+	// LineWindow is the number of scored characters DetectLines groups short
+	// lines into; zero detects every line on its own
+	LineWindow int
+	// Regions tells regional variants apart, such as pt-BR and pt-PT, and
+	// adds the region to Info.Tag
+	Regions bool
+	// Preferred lists the languages the reader is known to use, most preferred
+	// first, such as their UI languages or those of an Accept-Language header.
+	// They tip short and ambiguous texts towards those languages
+	Preferred []language.Tag
+	// Priors are the odds of each language before the text is read, such as
+	// DefaultPriors or ones from EstimatePriors; nil treats every language
+	// alike. A Detector is a small value, so set per-call priors on a copy
+	Priors Priors
+	// Model is the set of n-gram orders scored, such as ShortTextModel() or
+	// LongTextModel(); nil scores trigrams alone
+	Model Model
+	// Words adds the most frequent words of each language, such as "merci" or
+	// "danke", to the n-gram scores; it helps with queries of two or three words
+	Words bool
+}
+
+var defaultDetector Detector
+
+// FromString detects the language from the given string
+func (d Detector) FromString(text string) (Info, error) {
+	return d.detect(text, "")
+}
+
+// detect scores text, adding declaredBonus to the prior language if there is
+// one and the text gave it points
+func (d Detector) detect(text string, prior string) (Info, error) {
+	valid, invalid := sanitize(text, d.Invalid)
+	if invalid > 0 && d.Invalid == RejectInvalid {
+		return Info{lang: undetermined, langTag: undeterminedTag, invalid: invalid}, ErrInvalidText
+	}
+
+	model := d.Model
+	if model == nil {
+		model = TrigramModel()
+	} else if !model.valid() {
+		return Info{lang: undetermined, langTag: undeterminedTag, invalid: invalid}, ErrInvalidModel
+	}
+
+	scored := clean(normalize(valid, d.Form), d.Clean)
+	langMatches := matchAll(scored, model, d.Words)
+	addPriors(langMatches, d.Priors)
+	addPreferred(langMatches, d.Preferred)
+	if prior != "" && langMatches[prior] > 0 {
+		langMatches[prior] += declaredBonus
+	}
+	info := infoFrom(langMatches)
+	if d.Regions {
+		info = detectRegion(info, scored)
+	}
+	info.invalid = invalid
+	info.scored = scoredChars(scored)
+	return info, nil
+}
+
+// FromReader detects the language from an io.Reader
+//
+// This function will read all bytes until an EOF is reached
+func (d Detector) FromReader(reader io.Reader) (Info, error) {
+	b, err := ioutil.ReadAll(reader)
+	info, invalidErr := d.FromString(string(b))
+	if err == nil {
+		err = invalidErr
+	}
+	return info, err
+}
+
+// FromBytes detects the character encoding of the given bytes, decodes them and
+// detects the language of the decoded text
+func (d Detector) FromBytes(b []byte) (Info, error) {
+	cs, text := decode(b)
+	info, err := d.FromString(text)
+	info.charset = cs.name
+	return info, err
+}
+
+func normalize(text string, form norm.Form) string {
+	return form.String(width.Fold.String(text))
+}
+
+// sanitize applies the policy to invalid UTF-8, U+FFFD and control characters,
+// and drops invisible format characters such as zero-width joiners; it returns
+// the cleaned text and the number of invalid sequences and control characters
+//
+// A run of undecodable bytes counts as a single invalid sequence
+func sanitize(text string, policy InvalidPolicy) (string, int) {
+	var sb strings.Builder
+	sb.Grow(len(text))
+
+	var invalid int
+	var inBadBytes bool
+	for len(text) > 0 {
+		r, size := utf8.DecodeRuneInString(text)
+		text = text[size:]
+
+		badByte := r == utf8.RuneError && size == 1
+		if badByte && inBadBytes {
+			continue
+		}
+		inBadBytes = badByte
+
+		switch {
+		case r == utf8.RuneError || (unicode.IsControl(r) && !unicode.IsSpace(r)):
+			invalid++
+			if policy == ReplaceInvalid {
+				sb.WriteRune(' ')
+			}
+		case unicode.Is(unicode.Cf, r):
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String(), invalid
+}
@@ -0,0 +1,99 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"testing"
+)
+
+const corruptedEnglish = "We hold these\xff truths to be self-\x00evident, that all men are created equal"
+
+func TestReplaceInvalid(t *testing.T) {
+	info, err := Detector{}.FromString(corruptedEnglish)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "en", info.LanguageCode())
+	assert.Equal(t, 2, info.InvalidCount())
+}
+
+func TestSkipInvalid(t *testing.T) {
+	info, err := Detector{Invalid: SkipInvalid}.FromString(corruptedEnglish)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "en", info.LanguageCode())
+	assert.Equal(t, 2, info.InvalidCount())
+}
+
+func TestRejectInvalid(t *testing.T) {
+	info, err := Detector{Invalid: RejectInvalid}.FromString(corruptedEnglish)
+
+	assert.Equal(t, ErrInvalidText, err)
+	assert.Equal(t, "und", info.LanguageCode())
+	assert.Equal(t, 2, info.InvalidCount())
+}
+
+func TestRejectInvalidAcceptsCleanText(t *testing.T) {
+	info, err := Detector{Invalid: RejectInvalid}.FromReader(strings.NewReader("We hold these truths\tto be self-evident\r\n"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "en", info.LanguageCode())
+	assert.Equal(t, 0, info.InvalidCount())
+}
+
+func TestInvalidCountFlagsBinaryInput(t *testing.T) {
+	info := FromString("\x7fELF\x02\x01\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x3e\x00\x01\x00\x00\x00\x60\x10\xc3\xff")
+
+	assert.Equal(t, 22, info.InvalidCount())
+}
+
+func TestSanitizeDropsFormatCharacters(t *testing.T) {
+	clean, invalid := sanitize("می\u200cخواهم\u200d\ufeff", ReplaceInvalid)
+
+	assert.Equal(t, "میخواهم", clean)
+	assert.Equal(t, 0, invalid)
+}
+
+func TestSanitizePolicies(t *testing.T) {
+	replaced, _ := sanitize("ab\x00cd\xe2\x82ef\ufffd", ReplaceInvalid)
+	skipped, invalid := sanitize("ab\x00cd\xe2\x82ef\ufffd", SkipInvalid)
+
+	assert.Equal(t, "ab cd ef ", replaced)
+	assert.Equal(t, "abcdef", skipped)
+	assert.Equal(t, 3, invalid)
+}
+
+func TestNFDMatchesNFC(t *testing.T) {
+	for _, text := range []string{
+		"Truyền thông Việt Nam vào dịp này đăng bài ký tên ông",
+		"Wszyscy ludzie rodzą się wolni i równi w swojej godności i prawach",
+		"Příliš žluťoučký kůň úpěl ďábelské ódy",
+	} {
+		nfc := FromString(norm.NFC.String(text))
+		nfd := FromString(norm.NFD.String(text))
+
+		assert.Equal(t, nfc.LanguageCode(), nfd.LanguageCode(), text)
+		assert.InDelta(t, nfc.Confidence(), nfd.Confidence(), 1e-9, text)
+	}
+}
+
+func TestNFDFormKeepsCombiningMarks(t *testing.T) {
+	text := norm.NFC.String("Truyền thông Việt Nam vào dịp này đăng bài ký tên ông")
+	nfc := FromString(text)
+	nfd, _ := Detector{Form: norm.NFD}.FromString(text)
+
+	assert.True(t, nfd.Confidence() < nfc.Confidence())
+}
+
+func TestFullwidthLatin(t *testing.T) {
+	text := "Ｗｅ ｈｏｌｄ ｔｈｅｓｅ ｔｒｕｔｈｓ ｔｏ ｂｅ ｓｅｌｆ－ｅｖｉｄｅｎｔ"
+	ensureClassifiedWithConfidence(t, text, "en", 0.75)
+
+	info, _ := Detector{Form: norm.NFKC}.FromString(text)
+	assert.Equal(t, "en", info.LanguageCode())
+}
+
+func TestNormalizeFoldsWidth(t *testing.T) {
+	assert.Equal(t, "Go カタカナ", normalize("Ｇｏ ｶﾀｶﾅ", norm.NFC))
+	assert.Equal(t, "ガ", normalize("ｶﾞ", norm.NFC))
+}
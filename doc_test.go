@@ -31,3 +31,22 @@ func ExampleInfo_Tag() {
 	fmt.Println(getlang.FromString("何ですか？").Tag().IsRoot())
 	// Output: false
 }
+
+func ExampleInfo_Charset() {
+	koi8r := []byte{0xD3, 0xD4, 0xC1, 0xD4, 0xC5, 0xCA, 0x20, 0xCE, 0xC1, 0x20, 0xD2, 0xD5, 0xD3, 0xD3, 0xCB, 0xCF, 0xCD}
+	info := getlang.FromBytes(koi8r)
+	fmt.Println(info.LanguageCode(), info.Charset())
+	// Output: ru KOI8-R
+}
+
+func ExampleDetector_FromString() {
+	detector := getlang.Detector{Invalid: getlang.RejectInvalid}
+	_, err := detector.FromString("statement\x00\x00\x00\x00")
+	fmt.Println(err)
+	// Output: getlang: text contains invalid UTF-8 or control characters
+}
+
+func ExampleDetectScript() {
+	fmt.Println(getlang.DetectScript("何ですか？").Script())
+	// Output: Jpan
+}
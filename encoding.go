@@ -0,0 +1,304 @@
+package getlang
+
+import (
+	"bytes"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/encoding/unicode/utf32"
+	"golang.org/x/text/unicode/rangetable"
+	"io"
+	"io/ioutil"
+	"math"
+	"regexp"
+	"strings"
+	stdunicode "unicode"
+	"unicode/utf8"
+)
+
+const utf16ZeroRate = 0.4
+
+// utf16StrayZeroRate is how many zero bytes UTF-16 text may have at the other
+// byte position, relative to the position holding the high bytes; characters
+// such as U+4E00 have a zero low byte
+const utf16StrayZeroRate = 0.1
+
+// declarationLength is how far into a document a charset declaration is
+// looked for, as in the prescan of an HTML parser
+const declarationLength = 1024
+
+// charsetDeclaration matches the charset of a <meta> element or the encoding
+// of an XML declaration
+var charsetDeclaration = regexp.MustCompile(`(?i)<meta\s[^>]*charset\s*=\s*["']?\s*([\w.:-]+)|<\?xml\s[^>]*encoding\s*=\s*["']([\w.:-]+)`)
+
+// profileNoise is the share of trigrams that text in the wrong charset still
+// finds in some language profile
+const profileNoise = 0.1
+
+type charset struct {
+	name   string
+	enc    encoding.Encoding
+	common []*stdunicode.RangeTable
+}
+
+var utf8Charset = charset{"UTF-8", unicode.UTF8, nil}
+
+var boms = []struct {
+	bom []byte
+	cs  charset
+}{
+	{[]byte{0x00, 0x00, 0xFE, 0xFF}, charset{"UTF-32BE", utf32.UTF32(utf32.BigEndian, utf32.IgnoreBOM), nil}},
+	{[]byte{0xFF, 0xFE, 0x00, 0x00}, charset{"UTF-32LE", utf32.UTF32(utf32.LittleEndian, utf32.IgnoreBOM), nil}},
+	{[]byte{0xEF, 0xBB, 0xBF}, utf8Charset},
+	{[]byte{0xFE, 0xFF}, charset{"UTF-16BE", unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), nil}},
+	{[]byte{0xFF, 0xFE}, charset{"UTF-16LE", unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), nil}},
+}
+
+var (
+	westernLetters  = rangetable.New([]rune("àáâãäåæçèéêëìíîïñòóôõöøùúûüýÿßœ")...)
+	centralLetters  = rangetable.New([]rune("áäâăąćčďéęëěíîĺľłńňóôöőŕřśşšţťúůüűýźżž")...)
+	cyrillicLetters = rangetable.New([]rune("абвгдеёжзийклмнопрстуфхцчшщъыьэюяђєіїјљњћўџґ")...)
+	greekLetters    = rangetable.New([]rune("αβγδεζηθικλμνξοπρςστυφχψωάέήίόύώϊϋΐΰ")...)
+	hanziCommon     = rangetable.New([]rune("的一是不了人在有我他这中大来上国个到说们为子和你地出道也时年得就那要下以生会自着去之过家学对可她里后小么心多天而能好都然没日于起还发成事只作当想看文无开手十用主行方又如前所本见经头面公同三已老从动两长知民样现分将外但身些与高意进把法此实回二理美点月明其种声全工己话儿者向情部正名定女问力机给等几很业最间新什打便位因重被走电四第门相次东政海口使教西再平真听世气信北少关并内加化由却代军产入先山五太水万市眼体别处总才场师书比住员九笑性通目华报立马命张活难神数件安表原车白应路期叫死常提感金何更反合放做系计或司利受光王果亲界及今京务制解各任至清物台象记边共风战干接它许八特觉望直服毛林题建南度统色字请交爱让认算论百吃义科怎元社术结六功指思非流每青管夫连远资队跟带花快条院变联言权往展该领坐品运设")...)
+	hangulCommon    = rangetable.New([]rune("이다의는에가을를하고한지로서기사리있자도들어나대수여시정국일인적게과와으것부해보전우주제성아라면동원그만니요았었했되된할상경을위모때생유오간중내저장세은데까구소개실신")...)
+)
+
+// alphabets are the scripts a single word is not expected to mix
+var alphabets = []*stdunicode.RangeTable{stdunicode.Latin, stdunicode.Greek, stdunicode.Cyrillic}
+
+// legacyCharsets are tried in order when the input is neither UTF-8 nor UTF-16;
+// common holds the characters text in that charset is mostly made of. On a tie
+// the earlier charset wins
+var legacyCharsets = []charset{
+	{"EUC-KR", korean.EUCKR, []*stdunicode.RangeTable{hangulCommon}},
+	{"Shift_JIS", japanese.ShiftJIS, []*stdunicode.RangeTable{stdunicode.Hiragana, stdunicode.Katakana, hanziCommon}},
+	{"GB18030", simplifiedchinese.GB18030, []*stdunicode.RangeTable{hanziCommon}},
+	{"windows-1251", charmap.Windows1251, []*stdunicode.RangeTable{cyrillicLetters}},
+	{"KOI8-R", charmap.KOI8R, []*stdunicode.RangeTable{cyrillicLetters}},
+	{"ISO-8859-7", charmap.ISO8859_7, []*stdunicode.RangeTable{greekLetters}},
+	{"ISO-8859-1", charmap.ISO8859_1, []*stdunicode.RangeTable{westernLetters}},
+	{"windows-1252", charmap.Windows1252, []*stdunicode.RangeTable{westernLetters}},
+	{"ISO-8859-2", charmap.ISO8859_2, []*stdunicode.RangeTable{centralLetters}},
+	{"windows-1250", charmap.Windows1250, []*stdunicode.RangeTable{centralLetters}},
+}
+
+// FromBytes detects the character encoding of the given bytes, decodes them and
+// detects the language of the decoded text
+//
+// A byte order mark is honoured when present; otherwise UTF-8, UTF-16 and a set
+// of legacy encodings (Windows-125x, ISO-8859-x, KOI8-R, Shift_JIS, GB18030 and
+// EUC-KR) are tried. The detected encoding is reported by Info.Charset
+//
+// Input that is mostly valid UTF-8 is read as UTF-8; its stray bytes are
+// counted by Info.InvalidCount and handled by the Detector's InvalidPolicy
+func FromBytes(b []byte) Info {
+	info, _ := defaultDetector.FromBytes(b)
+	return info
+}
+
+// FromEncodedReader detects the character encoding and language from an io.Reader
+//
+// This function will read all bytes until an EOF is reached
+func FromEncodedReader(reader io.Reader) (Info, error) {
+	b, err := ioutil.ReadAll(reader)
+	return FromBytes(b), err
+}
+
+// decodeDocument decodes an HTML or XML document from the charset it declares,
+// falling back to detecting the charset when it has a byte order mark or
+// declares none that can be read
+func decodeDocument(b []byte) (charset, string) {
+	for _, m := range boms {
+		if bytes.HasPrefix(b, m.bom) {
+			return decode(b)
+		}
+	}
+
+	cs, ok := declaredCharset(b)
+	if !ok {
+		return decode(b)
+	}
+	if cs.name == utf8Charset.name {
+		// stray bytes are left in the text for the InvalidPolicy
+		return cs, string(b)
+	}
+	text, _ := cs.enc.NewDecoder().Bytes(b)
+	return cs, string(text)
+}
+
+// declaredCharset returns the charset declared at the start of a document;
+// a declaration of UTF-16 is ignored, as it could not be read in ASCII
+func declaredCharset(b []byte) (charset, bool) {
+	if len(b) > declarationLength {
+		b = b[:declarationLength]
+	}
+	m := charsetDeclaration.FindSubmatch(b)
+	if m == nil {
+		return charset{}, false
+	}
+	name := string(m[1]) + string(m[2])
+
+	enc, err := htmlindex.Get(name)
+	if err != nil {
+		return charset{}, false
+	}
+	canonical, _ := htmlindex.Name(enc)
+	switch {
+	case canonical == "utf-8":
+		return utf8Charset, true
+	case strings.HasPrefix(canonical, "utf-16"):
+		return charset{}, false
+	}
+	for _, cs := range legacyCharsets {
+		if cs.enc == enc {
+			return cs, true
+		}
+	}
+	return charset{name: canonical, enc: enc}, true
+}
+
+func decode(b []byte) (charset, string) {
+	for _, m := range boms {
+		if bytes.HasPrefix(b, m.bom) {
+			text, _ := m.cs.enc.NewDecoder().Bytes(b[len(m.bom):])
+			return m.cs, string(text)
+		}
+	}
+
+	if cs, ok := sniffUTF16(b); ok {
+		text, _ := cs.enc.NewDecoder().Bytes(b)
+		return cs, string(text)
+	}
+
+	if utf8.Valid(b) || mostlyUTF8(b) {
+		return utf8Charset, string(b)
+	}
+
+	best, bestText, bestScore := utf8Charset, string(b), -1.0
+	for _, cs := range legacyCharsets {
+		decoded, err := cs.enc.NewDecoder().Bytes(b)
+		if err != nil {
+			continue
+		}
+		text := string(decoded)
+		if score := charsetScore(cs, text); score > bestScore {
+			best, bestText, bestScore = cs, text, score
+		}
+	}
+	return best, bestText
+}
+
+// mostlyUTF8 reports whether most of the non-ASCII bytes form valid UTF-8
+// sequences; the stray bytes are left in the text for the InvalidPolicy, as a
+// legacy charset would turn them into letters
+func mostlyUTF8(b []byte) bool {
+	var valid, invalid int
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		if r == utf8.RuneError && size == 1 {
+			invalid++
+		} else if size > 1 {
+			valid += size
+		}
+		b = b[size:]
+	}
+	return valid > invalid
+}
+
+func sniffUTF16(b []byte) (charset, bool) {
+	if len(b) < 2 || len(b)%2 != 0 {
+		return charset{}, false
+	}
+
+	var evenZeros, oddZeros int
+	for i, c := range b {
+		if c != 0 {
+			continue
+		}
+		if i%2 == 0 {
+			evenZeros++
+		} else {
+			oddZeros++
+		}
+	}
+
+	half := float64(len(b) / 2)
+	switch {
+	case float64(oddZeros) > half*utf16ZeroRate && float64(evenZeros) <= float64(oddZeros)*utf16StrayZeroRate:
+		return charset{"UTF-16LE", unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM), nil}, true
+	case float64(evenZeros) > half*utf16ZeroRate && float64(oddZeros) <= float64(evenZeros)*utf16StrayZeroRate:
+		return charset{"UTF-16BE", unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM), nil}, true
+	}
+	return charset{}, false
+}
+
+// charsetScore adds the share of the decoded non-ASCII characters that are
+// common in the charset to the share of trigrams found in the best matching
+// language profile beyond profileNoise
+func charsetScore(cs charset, text string) float64 {
+	var chars, common int
+	var prev rune
+	for _, r := range text {
+		if r >= utf8.RuneSelf && !stdunicode.IsPunct(r) && !stdunicode.IsSpace(r) {
+			chars++
+			if isCommon(cs, r, prev) {
+				common++
+			}
+		}
+		prev = r
+	}
+
+	fit := 1.0
+	if chars > 0 {
+		fit = float64(common) / float64(chars)
+	}
+	return fit + math.Max(profileFit(text)-profileNoise, 0)
+}
+
+// isCommon reports whether r is common in the charset; capitals only count at
+// the start of a word and a word does not switch between alphabets
+func isCommon(cs charset, r, prev rune) bool {
+	if r == utf8.RuneError || isHalfwidth(r) {
+		return false
+	}
+	if stdunicode.IsLetter(prev) {
+		if stdunicode.IsUpper(r) || alphabetOf(prev) != alphabetOf(r) {
+			return false
+		}
+	}
+	return stdunicode.In(stdunicode.ToLower(r), cs.common...)
+}
+
+func alphabetOf(r rune) *stdunicode.RangeTable {
+	for _, table := range alphabets {
+		if stdunicode.Is(table, r) {
+			return table
+		}
+	}
+	return nil
+}
+
+func profileFit(text string) float64 {
+	trigs := sortedNgrams(text, 3)
+	var total int
+	for _, trig := range trigs {
+		total += trig.count
+	}
+	if total == 0 {
+		return 0
+	}
+
+	matches := make(map[string]int)
+	for k := range langs {
+		matchWith(k, trigs, Order{N: 3, Weight: 1}, matches)
+	}
+	return float64(matches[maxKey(matches)]) / float64(total)
+}
+
+func isHalfwidth(r rune) bool {
+	return r >= 0xFF61 && r <= 0xFFDC
+}
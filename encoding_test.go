@@ -0,0 +1,226 @@
+package getlang
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode"
+	"strings"
+	"testing"
+)
+
+func TestWindows1251FromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"Все люди рождаются свободными и равными в своем достоинстве и правах",
+		charmap.Windows1251,
+		"windows-1251",
+		"ru")
+}
+
+func TestKOI8RFromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"Все люди рождаются свободными и равными в своем достоинстве и правах",
+		charmap.KOI8R,
+		"KOI8-R",
+		"ru")
+}
+
+func TestISO88592FromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"Wszyscy ludzie rodzą się wolni i równi w swojej godności i prawach",
+		charmap.ISO8859_2,
+		"ISO-8859-2",
+		"pl")
+}
+
+func TestISO88597FromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"Ολοι οι άνθρωποι γεννιούνται ελεύθεροι και ίσοι στην αξιοπρέπεια και στα δικαιώματα",
+		charmap.ISO8859_7,
+		"ISO-8859-7",
+		"el")
+}
+
+func TestWindows1252FromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"Tous les êtres humains naissent libres et égaux en dignité et en droits",
+		charmap.Windows1252,
+		"ISO-8859-1",
+		"fr")
+}
+
+func TestShiftJISFromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"すべての人間は、生まれながらにして自由であり、かつ、尊厳と権利とについて平等である。",
+		japanese.ShiftJIS,
+		"Shift_JIS",
+		"ja")
+}
+
+func TestGB18030FromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"人人生而自由，在尊严和权利上一律平等。他们赋有理性和良心，并应以兄弟关系的精神相对待。",
+		simplifiedchinese.GB18030,
+		"GB18030",
+		"zh")
+}
+
+func TestEUCKRFromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"모든 인간은 태어날 때부터 자유로우며 그 존엄과 권리에 있어 동등하다.",
+		korean.EUCKR,
+		"EUC-KR",
+		"ko")
+}
+
+func TestUTF16WithBOMFromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"We hold these truths to be self-evident, that all men are created equal",
+		unicode.UTF16(unicode.LittleEndian, unicode.UseBOM),
+		"UTF-16LE",
+		"en")
+
+	ensureDecodedAndClassified(
+		t,
+		"Все люди рождаются свободными и равными в своем достоинстве и правах",
+		unicode.UTF16(unicode.BigEndian, unicode.UseBOM),
+		"UTF-16BE",
+		"ru")
+}
+
+func TestUTF16WithoutBOMFromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"We hold these truths to be self-evident, that all men are created equal",
+		unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM),
+		"UTF-16LE",
+		"en")
+}
+
+func TestUTF8WithBOMFromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"Wir halten diese Wahrheiten für ausgemacht, daß alle Menschen gleich erschaffen worden",
+		unicode.UTF8BOM,
+		"UTF-8",
+		"de")
+}
+
+func TestFromEncodedReader(t *testing.T) {
+	encoded, _ := charmap.Windows1251.NewEncoder().String("статей на русском")
+	info, err := FromEncodedReader(bytes.NewReader([]byte(encoded)))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "ru", info.LanguageCode())
+	assert.Equal(t, "windows-1251", info.Charset())
+}
+
+func TestMostlyUTF8FromBytes(t *testing.T) {
+	b := []byte("Все люди рождаются свободными \xff и равными в своем достоинстве и правах")
+	info := FromBytes(b)
+
+	assert.Equal(t, "UTF-8", info.Charset())
+	assert.Equal(t, "ru", info.LanguageCode())
+	assert.Equal(t, 1, info.InvalidCount())
+
+	info, err := Detector{Invalid: RejectInvalid}.FromBytes(b)
+	assert.Equal(t, ErrInvalidText, err)
+	assert.Equal(t, "und", info.LanguageCode())
+	assert.Equal(t, "UTF-8", info.Charset())
+}
+
+func TestMostlyUTF8(t *testing.T) {
+	latin1, _ := charmap.ISO8859_1.NewEncoder().String("Wir halten für ausgemacht, daß")
+
+	assert.True(t, mostlyUTF8([]byte("für\xff daß")))
+	assert.False(t, mostlyUTF8([]byte(latin1)))
+	assert.False(t, mostlyUTF8([]byte("plain \xff ASCII")))
+}
+
+func TestCharsetEmptyFromString(t *testing.T) {
+	assert.Equal(t, "", FromString("this is the language").Charset())
+}
+
+func ensureDecodedAndClassified(t *testing.T, text string, enc encoding.Encoding, expectedCharset string, expectedLang string) {
+	encoded, err := enc.NewEncoder().Bytes([]byte(text))
+	assert.Nil(t, err)
+
+	info := FromBytes(encoded)
+	assert.Equal(t, expectedCharset, info.Charset(), "Wrong charset: "+text)
+	assert.Equal(t, expectedLang, info.LanguageCode(), "Misclassified text: "+text)
+}
+
+func TestUTF16WithZeroLowBytesFromBytes(t *testing.T) {
+	ensureDecodedAndClassified(
+		t,
+		"We hold these truths 一 to be self-evident, that all men 一 are created equal",
+		unicode.UTF16(unicode.LittleEndian, unicode.IgnoreBOM),
+		"UTF-16LE",
+		"en")
+
+	ensureDecodedAndClassified(
+		t,
+		"We hold these truths 一 to be self-evident, that all men 一 are created equal",
+		unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM),
+		"UTF-16BE",
+		"en")
+}
+
+func TestDeclaredCharset(t *testing.T) {
+	page, err := charmap.Windows1250.NewEncoder().String(`<html><head><meta http-equiv="Content-Type" content="text/html; charset=windows-1250"></head>` +
+		`<body><p>Všichni lidé rodí se svobodní a sobě rovní co do důstojnosti a práv.</p></body></html>`)
+	assert.Nil(t, err)
+
+	info, err := FromHTML(strings.NewReader(page))
+	assert.Nil(t, err)
+	assert.Equal(t, "windows-1250", info.Charset())
+	assert.Equal(t, "cs", info.LanguageCode())
+
+	xml, err := charmap.KOI8R.NewEncoder().String(`<?xml version="1.0" encoding="KOI8-R"?>` +
+		`<p>Все люди рождаются свободными и равными в своем достоинстве и правах.</p>`)
+	assert.Nil(t, err)
+
+	doc, err := FromDocument(strings.NewReader(xml), HTML)
+	assert.Nil(t, err)
+	assert.Equal(t, "KOI8-R", doc.Charset())
+	assert.Equal(t, "ru", doc.LanguageCode())
+}
+
+func TestDeclaredCharsetIsTrustedOverGuess(t *testing.T) {
+	// both texts are too short for the charset to be guessed right
+	page, err := charmap.ISO8859_7.NewEncoder().String(`<?xml version="1.0" encoding="ISO-8859-7"?><p>Όλοι οι άνθρωποι</p>`)
+	assert.Nil(t, err)
+
+	info, _ := FromHTML(strings.NewReader(page))
+	assert.Equal(t, "ISO-8859-7", info.Charset())
+	assert.Equal(t, "el", info.LanguageCode())
+
+	page, err = charmap.Windows1250.NewEncoder().String(`<meta charset="windows-1250"><p>Żółć i łzy</p>`)
+	assert.Nil(t, err)
+
+	info, _ = FromHTML(strings.NewReader(page))
+	assert.Equal(t, "windows-1250", info.Charset())
+	assert.Equal(t, "pl", info.LanguageCode())
+}
+
+func TestUndeclaredCharsetIsDetected(t *testing.T) {
+	page, err := charmap.Windows1251.NewEncoder().String(`<p>Все люди рождаются свободными и равными в своем достоинстве и правах.</p>`)
+	assert.Nil(t, err)
+
+	info, _ := FromHTML(strings.NewReader(page))
+	assert.Equal(t, "windows-1251", info.Charset())
+	assert.Equal(t, "ru", info.LanguageCode())
+}
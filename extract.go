@@ -0,0 +1,230 @@
+package getlang
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Extractor pulls the natural language text out of a structured document
+type Extractor interface {
+	// Name identifies the extractor in Info.Extractor
+	Name() string
+	// Extract returns the text of the document that should be scored
+	Extract(document string) (string, error)
+}
+
+var (
+	// HTML extracts the visible text of HTML and XML documents
+	HTML Extractor = htmlExtractor{}
+	// Markdown extracts prose from Markdown, skipping code, link targets and markup
+	Markdown Extractor = markdownExtractor{}
+	// SourceCode extracts comments and string literals from Go and other C-style source
+	SourceCode Extractor = sourceCodeExtractor{}
+	// JSON extracts the string values of a JSON document, skipping object keys
+	JSON Extractor = jsonExtractor{}
+)
+
+// FromDocument detects the language of the text an extractor finds in a document
+//
+// A charset declared in a <meta> element or XML declaration is used to decode
+// the document; otherwise it is detected as by FromBytes. This function will
+// read all bytes until an EOF is reached
+func FromDocument(reader io.Reader, extractor Extractor) (Info, error) {
+	return defaultDetector.FromDocument(reader, extractor)
+}
+
+// FromDocument detects the language of the text an extractor finds in a document
+func (d Detector) FromDocument(reader io.Reader, extractor Extractor) (Info, error) {
+	b, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return Info{lang: undetermined, langTag: undeterminedTag, extractor: extractor.Name()}, err
+	}
+
+	cs, document := decodeDocument(b)
+	text, err := extractor.Extract(document)
+	if err != nil {
+		return Info{lang: undetermined, langTag: undeterminedTag, charset: cs.name, extractor: extractor.Name()}, err
+	}
+
+	info, err := d.FromString(text)
+	info.charset = cs.name
+	info.extractor = extractor.Name()
+	return info, err
+}
+
+type htmlExtractor struct{}
+
+func (htmlExtractor) Name() string {
+	return "html"
+}
+
+func (htmlExtractor) Extract(document string) (string, error) {
+	text, _ := extractHTML(document)
+	return text, nil
+}
+
+type markdownExtractor struct{}
+
+var (
+	markdownFence      = regexp.MustCompile("^ {0,3}(```|~~~)")
+	markdownReference  = regexp.MustCompile(`^ {0,3}\[[^\]]+\]:\s`)
+	markdownInlineCode = regexp.MustCompile("`+[^`]*`+")
+	markdownLink       = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	markdownAutolink   = regexp.MustCompile(`<[a-zA-Z][a-zA-Z0-9+.-]*:[^>\s]*>`)
+	markdownTag        = regexp.MustCompile(`</?[a-zA-Z][^>]*>`)
+	markdownSyntax     = regexp.MustCompile(`[*_~|>#=+]+`)
+)
+
+func (markdownExtractor) Name() string {
+	return "markdown"
+}
+
+func (markdownExtractor) Extract(document string) (string, error) {
+	var sb strings.Builder
+	var fence string
+	previousBlank := true
+
+	for _, line := range strings.Split(document, "\n") {
+		if fence != "" {
+			if strings.HasPrefix(strings.TrimSpace(line), fence) {
+				fence = ""
+			}
+			continue
+		}
+		if m := markdownFence.FindStringSubmatch(line); m != nil {
+			fence = m[1]
+			continue
+		}
+
+		blank := strings.TrimSpace(line) == ""
+		indented := strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t")
+		if (indented && previousBlank) || markdownReference.MatchString(line) {
+			continue
+		}
+		previousBlank = blank
+
+		line = markdownInlineCode.ReplaceAllString(line, " ")
+		line = markdownLink.ReplaceAllString(line, "$1")
+		line = markdownAutolink.ReplaceAllString(line, " ")
+		line = markdownTag.ReplaceAllString(line, " ")
+		line = markdownSyntax.ReplaceAllString(line, " ")
+		sb.WriteString(line)
+		sb.WriteByte('\n')
+	}
+	return sb.String(), nil
+}
+
+type sourceCodeExtractor struct{}
+
+func (sourceCodeExtractor) Name() string {
+	return "source"
+}
+
+// Extract scans comments and string literals; characters and anything else
+// between them are skipped
+func (sourceCodeExtractor) Extract(document string) (string, error) {
+	var sb strings.Builder
+	emit := func(s string) {
+		sb.WriteString(s)
+		sb.WriteByte('\n')
+	}
+
+	for i := 0; i < len(document); {
+		rest := document[i:]
+		switch {
+		case strings.HasPrefix(rest, "//"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			emit(rest[2:end])
+			i += end
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				emit(rest[2:])
+				return sb.String(), nil
+			}
+			emit(rest[2 : end+2])
+			i += end + 4
+		case rest[0] == '"' || rest[0] == '\'' || rest[0] == '`':
+			end := literalEnd(rest)
+			if rest[0] != '\'' {
+				emit(unquoteLiteral(rest[:end]))
+			}
+			i += end
+		default:
+			i++
+		}
+	}
+	return sb.String(), nil
+}
+
+// literalEnd finds the end of the quoted literal at the start of s; only raw
+// backquoted literals span lines
+func literalEnd(s string) int {
+	quote := s[0]
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quote != '`':
+			i++
+		case s[i] == quote:
+			return i + 1
+		case s[i] == '\n' && quote != '`':
+			return i
+		}
+	}
+	return len(s)
+}
+
+func unquoteLiteral(literal string) string {
+	if unquoted, err := strconv.Unquote(literal); err == nil {
+		return unquoted
+	}
+	return strings.Trim(literal, "\"`")
+}
+
+type jsonExtractor struct{}
+
+func (jsonExtractor) Name() string {
+	return "json"
+}
+
+func (jsonExtractor) Extract(document string) (string, error) {
+	var value interface{}
+	dec := json.NewDecoder(strings.NewReader(document))
+	dec.UseNumber()
+	if err := dec.Decode(&value); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	collectStrings(value, &sb)
+	return sb.String(), nil
+}
+
+func collectStrings(value interface{}, sb *strings.Builder) {
+	switch v := value.(type) {
+	case string:
+		sb.WriteString(v)
+		sb.WriteByte('\n')
+	case []interface{}:
+		for _, item := range v {
+			collectStrings(item, sb)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			collectStrings(v[k], sb)
+		}
+	}
+}
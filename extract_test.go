@@ -0,0 +1,98 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const markdownDocument = "# Einleitung\n" +
+	"\n" +
+	"Alle Menschen sind **frei** und gleich an Würde und Rechten geboren. Siehe [die Erklärung](https://example.com/menschenrechte/index.html).\n" +
+	"\n" +
+	"```go\n" +
+	"func main() { fmt.Println(\"hello world\") }\n" +
+	"```\n" +
+	"\n" +
+	"    indentedCode := true\n" +
+	"\n" +
+	"Rufe `getlang.FromString` auf.\n" +
+	"\n" +
+	"[ref]: https://example.com/reference\n"
+
+const goSource = `package main
+
+// Sie sind mit Vernunft und Gewissen begabt und sollen einander im Geist der Brüderlichkeit begegnen.
+func main() {
+	/* Jeder hat Anspruch auf die in dieser Erklärung verkündeten Rechte */
+	message := "Alle Menschen sind frei und gleich an Würde und Rechten geboren.\n"
+	sep := '"'
+	fmt.Println(message, sep, ` + "`und Freiheiten`" + `)
+}
+`
+
+const jsonDocument = `{
+	"id": "user_profile_settings",
+	"count": 3,
+	"messages": ["Todos los seres humanos nacen libres e iguales en dignidad y derechos", null, true],
+	"nested": {"hint": "y, dotados como están de razón y conciencia"}
+}`
+
+func TestMarkdownExtractor(t *testing.T) {
+	text, err := Markdown.Extract(markdownDocument)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Einleitung Alle Menschen sind frei und gleich an Würde und Rechten geboren. Siehe die Erklärung. Rufe auf.", words(text))
+}
+
+func TestSourceCodeExtractor(t *testing.T) {
+	text, err := SourceCode.Extract(goSource)
+
+	assert.Nil(t, err)
+	assert.Equal(t,
+		"Sie sind mit Vernunft und Gewissen begabt und sollen einander im Geist der Brüderlichkeit begegnen. "+
+			"Jeder hat Anspruch auf die in dieser Erklärung verkündeten Rechte "+
+			"Alle Menschen sind frei und gleich an Würde und Rechten geboren. "+
+			"und Freiheiten",
+		words(text))
+}
+
+func TestJSONExtractor(t *testing.T) {
+	text, err := JSON.Extract(jsonDocument)
+
+	assert.Nil(t, err)
+	assert.Equal(t,
+		"user_profile_settings Todos los seres humanos nacen libres e iguales en dignidad y derechos y, dotados como están de razón y conciencia",
+		words(text))
+}
+
+func TestFromDocument(t *testing.T) {
+	for _, c := range []struct {
+		document  string
+		extractor Extractor
+		lang      string
+	}{
+		{markdownDocument, Markdown, "de"},
+		{goSource, SourceCode, "de"},
+		{jsonDocument, JSON, "es"},
+		{germanPage, HTML, "de"},
+	} {
+		info, err := FromDocument(strings.NewReader(c.document), c.extractor)
+
+		assert.Nil(t, err)
+		assert.Equal(t, c.lang, info.LanguageCode(), c.extractor.Name())
+		assert.Equal(t, c.extractor.Name(), info.Extractor())
+	}
+}
+
+func TestFromDocumentInvalidJSON(t *testing.T) {
+	info, err := FromDocument(strings.NewReader(`{"text": "unterminated`), JSON)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, "und", info.LanguageCode())
+	assert.Equal(t, "json", info.Extractor())
+}
+
+func TestPlainTextHasNoExtractor(t *testing.T) {
+	assert.Equal(t, "", FromString("plain text").Extractor())
+}
@@ -7,53 +7,197 @@ package getlang
 import (
 	"golang.org/x/text/language"
 	"golang.org/x/text/language/display"
+	"golang.org/x/text/unicode/rangetable"
 	"io"
-	"io/ioutil"
 	"math"
 	"sort"
+	"strings"
 	"unicode"
 )
 
-const undeterminedRate int = 41
+// undeterminedRates is how many n-grams of each order missing from a profile
+// add a point to the undetermined score; in text of the profile's own language,
+// quadgrams miss a profile about twice and 5-grams three and a half times as
+// often as trigrams do
+var undeterminedRates = [maxOrder + 1]int{1: 41, 2: 41, 3: 41, 4: 80, 5: 140}
+
+// undeterminedProfiles is the number of Latin profiles undeterminedRates were
+// tuned for; the undetermined score is scaled to it so it does not grow with
+// every profile added
+const undeterminedProfiles int = 16
 const undetermined string = "und"
-const rescale = 0.5
+
+// rescale sharpens the softmax; with more than twice the original profiles
+// sharing each script, 0.5 spreads the probability of short texts too thin
+const rescale = 0.6
 const scriptCountFactor int = 2
+const letterCountFactor int = 4
+const markerCountFactor int = 4
+const evidencePenalty int = 3
 const expOverflow = 7.09e+02
+const maxCandidates int = 5
+const minCandidateProbability = 0.01
+
+var undeterminedTag = language.MustParse(undetermined)
 
 var langs = map[string][]string{
+	"am":      am,
+	"ar-Latn": arLatin,
+	"az":      az,
+	"ca":      ca,
+	"cs":      cs,
 	"de":      de,
 	"en":      en,
 	"es":      es,
 	"fr":      fr,
+	"gl":      gl,
+	"ha":      ha,
 	"hi":      hi,
+	"hi-Latn": hiLatin,
+	"hr":      hr,
 	"hu":      hu,
+	"id":      id,
 	"it":      it,
+	"ja-Latn": jaLatin,
+	"ms":      ms,
 	"nl":      nl,
 	"pl":      pl,
 	"pt":      pt,
+	"ro":      ro,
 	"ru":      ru,
+	"sk":      sk,
+	"sl":      sl,
+	"so":      so,
 	"sr-Latn": srLatin,
 	"sr-Cyrl": srCyr,
+	"sw":      sw,
+	"ti":      ti,
 	"tl":      tl,
+	"tr":      tr,
 	"uk":      uk,
+	"uz-Latn": uzLatin,
+	"uz-Cyrl": uzCyr,
 	"vi":      vi,
+	"yo":      yo,
+	"zh-Latn": zhLatin,
+	"zu":      zu,
 }
 
 var scripts = map[string][]*unicode.RangeTable{
 	"ar": {unicode.Arabic},
-	"bn": {unicode.Bengali},
+	"bo": {unicode.Tibetan},
 	"el": {unicode.Greek},
 	"gu": {unicode.Gujarati},
 	"he": {unicode.Hebrew},
 	"hy": {unicode.Armenian},
 	"ja": {unicode.Hiragana, unicode.Katakana},
+	"ka": {unicode.Georgian},
+	"km": {unicode.Khmer},
 	"kn": {unicode.Kannada},
 	"ko": {unicode.Hangul},
+	"lo": {unicode.Lao},
+	"ml": {unicode.Malayalam},
+	"my": {unicode.Myanmar}, // also written in Shan and Karen
+	"or": {unicode.Oriya},
 	"pa": {unicode.Gurmukhi},
+	"si": {unicode.Sinhala},
 	"ta": {unicode.Tamil},
 	"te": {unicode.Telugu},
 	"th": {unicode.Thai},
-	"zh": {unicode.Han},
+}
+
+// sharedScripts maps a script to the languages written in it; the script score
+// goes to whichever of them already scores best, or to the first one
+var sharedScripts = map[*unicode.RangeTable][]string{
+	unicode.Bengali:  {"bn", "as"},
+	unicode.Ethiopic: {"am", "ti"},
+	unicode.Han:      {"zh", "ja", "ko"},
+}
+
+// letters holds the characters that set a language apart from its closest relatives
+var letters = map[string]*unicode.RangeTable{
+	"as":      rangetable.New('ৰ', 'ৱ'),
+	"az":      rangetable.New([]rune("əƏ")...),
+	"ca":      rangetable.New([]rune("àèòï·ÀÈÒÏ")...),
+	"cs":      rangetable.New([]rune("ěřůĚŘŮ")...),
+	"ha":      rangetable.New([]rune("ƙɗɓƴƘƊƁƳ")...),
+	"pt":      rangetable.New([]rune("ãõÃÕ")...),
+	"ro":      rangetable.New([]rune("ăâîșşțţĂÂÎȘŞȚŢ")...),
+	"sk":      rangetable.New([]rune("äĺľŕôÄĹĽŔÔ")...),
+	"uz-Cyrl": rangetable.New([]rune("ўқғҳЎҚҒҲ")...),
+	"yo":      rangetable.New([]rune("ṣṢ\u0329")...),
+	"zh-Latn": rangetable.New([]rune("ǎǐǒǔǚǘǜǍǏǑǓǙǗǛ")...),
+}
+
+// markers holds words that set a language apart from its closest relatives
+var markers = map[string][]string{
+	"az":      {"və", "çox", "üçün", "deyil", "ilə", "nə", "kimi", "amma", "bəli", "xeyr"},
+	"id":      {"bisa", "karena", "saja", "pemerintah", "kantor", "mobil", "uang", "kamu", "nggak", "gimana", "aja"},
+	"ms":      {"kerana", "sahaja", "kerajaan", "pejabat", "kereta", "wang", "awak", "tak", "nak", "baharu", "petang", "ramai"},
+	"tr":      {"ve", "çok", "için", "değil", "ile", "gibi", "ama", "şey", "evet", "hayır", "mı", "mi", "mu", "mü"},
+	"hr":      serboCroatianMarkers,
+	"sr-Latn": serboCroatianMarkers,
+}
+
+// serboCroatianMarkers holds words Serbian and Croatian share but Slovenian does not
+var serboCroatianMarkers = []string{
+	"jer", "uglavnom", "šta", "što", "nije", "nisam", "nismo", "bio", "bila", "su", "će", "ću", "ćemo",
+	"ovaj", "ovo", "koji", "koja", "koje", "svi", "sve", "kada", "zbog", "vrlo", "nešto", "ništa",
+}
+
+// evidence holds the words a language needs when most of its n-grams are
+// shared with a relative; without one of them it loses evidencePenalty points,
+// so text that reads the same in both goes to the relative
+//
+// Croatian needs its own words to win over Serbian, which getlang has always
+// reported for Serbo-Croatian text; romanized Arabic, Hindi, Japanese and
+// Chinese need theirs to win over the languages native to the Latin script
+var evidence = map[string][]string{
+	"ar-Latn": {
+		"ezay", "ezayak", "ezayek", "izzayak", "habibi", "habibti", "shukran", "yalla", "inshallah", "wallah", "keefak",
+		"kifak", "kifik", "fein", "feen", "ahlan", "marhaba", "aywa", "mesh", "mish", "leh", "lesh", "enta", "inta",
+		"enti", "inti", "howa", "heya", "kteer", "ktir", "awi", "mafi", "tayeb", "akhi", "ukhti", "khalas", "ya3ni",
+	},
+	"hi-Latn": {
+		"hain", "nahi", "nahin", "kya", "mujhe", "mera", "meri", "mere", "tera", "tumhara", "aap", "aapka", "kaise",
+		"kahan", "kab", "kyun", "bahut", "accha", "acha", "bhai", "yaar", "haan", "woh", "yeh", "hum", "hamara",
+		"raha", "rahi", "rahe", "aaj", "pata", "kuch", "abhi", "namaste", "shukriya", "dhanyavaad", "gaya", "gayi",
+		"aayega", "dekho", "matlab", "zindagi", "pyaar", "haal", "chal", "theek", "thik", "hoon",
+	},
+	"hr": {
+		"tko", "netko", "nitko", "svatko", "kruh", "tjedan", "tisuća", "sveučilište", "kazalište", "zrakoplov",
+		"vlak", "kolodvor", "glazba", "znanost", "obitelj", "tijekom", "izvješće", "općina", "vijesti", "lijep",
+		"lijepo", "lijepa", "vrijeme", "mlijeko", "rijeka", "dijete", "cijeli", "cijela", "svijet", "uvijek",
+		"gdje", "ovdje", "htio", "sretan", "točno", "također", "jučer", "riječ", "riječi", "mjesto",
+		"mjesec", "vjerojatno", "hrvatski", "hrvatska",
+	},
+	"ja-Latn": {
+		"desu", "masu", "deshita", "mashita", "watashi", "anata", "arigatou", "arigato", "gozaimasu", "konnichiwa",
+		"konbanwa", "ohayou", "ohayo", "sayonara", "sayounara", "sumimasen", "gomen", "gomennasai", "kudasai", "onegai",
+		"shimasu", "iie", "doko", "nani", "dare", "itsu", "naze", "douzo", "hajimemashite", "yoroshiku", "itadakimasu",
+		"ashita", "kyou", "kinou", "genki", "daijoubu", "sugoi", "kawaii", "wakarimasen", "wakarimasu", "hontou", "totemo",
+	},
+	"zh-Latn": {
+		"shi", "zai", "zhe", "hao", "xiexie", "nihao", "shenme", "nali", "women", "nimen", "tamen", "xiang", "qu",
+		"beijing", "zhongguo", "meiyou", "keyi", "xihuan", "zaijian", "duibuqi", "jiu", "dou", "yao", "lvyou",
+		"pengyou", "laoshi", "xuesheng", "shuo", "zhidao", "renshi", "gongzuo", "jintian", "mingtian", "zuotian",
+		"xianzai", "yidian", "yiqi", "zenme", "weishenme", "yinwei", "suoyi", "danshi", "ranhou", "haishi",
+	},
+}
+
+// evidenceLetters holds characters that count as evidence in any word, such
+// as the digits Arabizi writes for Arabic sounds or the tone marks of pinyin
+var evidenceLetters = map[string]*unicode.RangeTable{
+	"ar-Latn": rangetable.New([]rune("235679")...),
+	"zh-Latn": rangetable.New([]rune("āēīōūǖǎěǐǒǔǚǘǜĀĒĪŌŪǕǍĚǏǑǓǙǗǛ")...),
+}
+
+// alphanumericWords are common words with a digit between letters that are
+// not Arabizi
+var alphanumericWords = map[string]bool{
+	"a2a": true, "b2b": true, "b2c": true, "c2c": true, "e2e": true, "h2o": true,
+	"p2p": true, "t2t": true, "u2f": true, "w3c": true, "y2k": true, "i18n": true,
+	"l10n": true, "k8s": true, "x86": true,
 }
 
 // Info is the language detection result
@@ -61,6 +205,13 @@ type Info struct {
 	lang        string
 	probability float64
 	langTag     language.Tag
+	charset     string
+	invalid     int
+	scored      int
+	extractor   string
+	candidates  [maxCandidates]candidate
+
+	regionProbability float64
 }
 
 // Tag returns the language.Tag of the detected language
@@ -68,13 +219,10 @@ func (info Info) Tag() language.Tag {
 	return info.langTag
 }
 
-// LanguageCode returns the ISO 639-1 code for the detected language
+// LanguageCode returns the ISO 639-1 code for the detected language, or its
+// ISO 639-3 code when it has no two-letter one
 func (info Info) LanguageCode() string {
-	codeLen := len(info.lang)
-	if codeLen < 4 {
-		return info.lang
-	}
-	return info.lang[:2]
+	return strings.SplitN(info.lang, "-", 2)[0]
 }
 
 // Confidence returns a measure of reliability for the language classification
@@ -94,38 +242,202 @@ func (info Info) SelfName() string {
 	return display.Self.Name(info.langTag)
 }
 
+// Candidates returns the likeliest languages, best first, with the detected
+// language at the head of the list
+//
+// Languages with less than 1% of the probability are left out
+func (info Info) Candidates() []Info {
+	var candidates []Info
+	for _, c := range info.candidates {
+		if c.lang == "" {
+			break
+		}
+		candidates = append(candidates, Info{lang: c.lang, probability: c.probability, langTag: language.MustParse(c.lang)})
+	}
+	return candidates
+}
+
+// WithoutCandidates returns info with the runners-up dropped, so that its JSON
+// form leaves out the candidates
+func (info Info) WithoutCandidates() Info {
+	info.candidates = [maxCandidates]candidate{}
+	return info
+}
+
+// Charset returns the name of the character encoding the text was decoded from
+//
+// It is empty unless the text came from FromBytes or FromEncodedReader
+func (info Info) Charset() string {
+	return info.charset
+}
+
+// RegionConfidence returns a measure of reliability for the region in Tag
+//
+// It is 0 unless the Detector looked for regional variants and found one
+func (info Info) RegionConfidence() float64 {
+	return info.regionProbability
+}
+
+// Extractor returns the name of the extractor that pulled the text out of a
+// document, such as "html", "markdown", "source" or "json"
+//
+// It is empty for plain text
+func (info Info) Extractor() string {
+	return info.extractor
+}
+
+// InvalidCount returns the number of invalid UTF-8 sequences and control
+// characters found in the input
+//
+// A high count usually means binary or corrupted input
+func (info Info) InvalidCount() int {
+	return info.invalid
+}
+
+// ScoredChars returns how many characters of the input were used for scoring,
+// after whitespace, punctuation and anything removed by cleaning
+//
+// Results based on a handful of characters are unreliable
+func (info Info) ScoredChars() int {
+	return info.scored
+}
+
 // FromReader detects the language from an io.Reader
 //
 // This function will read all bytes until an EOF is reached
 func FromReader(reader io.Reader) (Info, error) {
-	bytes, err := ioutil.ReadAll(reader)
-	return FromString(string(bytes)), err
+	return defaultDetector.FromReader(reader)
 }
 
 // FromString detects the language from the given string
 func FromString(text string) Info {
+	info, _ := defaultDetector.FromString(text)
+	return info
+}
+
+func infoFrom(langMatches map[string]int) Info {
+	smx := softMax(langMatches)
+	maxk := maxKey(langMatches)
+	info := Info{lang: maxk, probability: smx[maxk], langTag: language.MustParse(maxk)}
+	info.candidates = candidatesFrom(langMatches, smx, maxk)
+	return info
+}
+
+// candidate is a runner-up kept in Info; an array of them keeps Info comparable
+type candidate struct {
+	lang        string
+	probability float64
+}
+
+// candidatesFrom lists the best match and the runners-up after it, until they
+// hold all of the probability or maxCandidates is reached
+func candidatesFrom(langMatches map[string]int, smx map[string]float64, best string) [maxCandidates]candidate {
+	var keys []string
+	for k := range langMatches {
+		if k != best && smx[k] >= minCandidateProbability {
+			keys = append(keys, k)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if langMatches[keys[i]] != langMatches[keys[j]] {
+			return langMatches[keys[i]] > langMatches[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	var candidates [maxCandidates]candidate
+	candidates[0] = candidate{best, smx[best]}
+	total := smx[best]
+	for i, k := range keys {
+		if i+1 == maxCandidates || total >= 1-minCandidateProbability {
+			break
+		}
+		candidates[i+1] = candidate{k, smx[k]}
+		total += smx[k]
+	}
+	return candidates
+}
+
+// matchAll scores text in two stages: it finds the script most of the text is
+// written in, then scores only the languages written in that script; frequent
+// adds the frequent words of each language
+func matchAll(text string, model Model, frequent bool) map[string]int {
 	langMatches := make(map[string]int)
 	langMatches[undetermined] = 1
 
-	trigs := sortedTrigs(text)
-	for k, v := range langs {
-		matchWith(k, trigs, v, langMatches)
+	counts, _ := countScripts(text)
+	script := dominantScript(counts)
+	candidates := scriptLanguages[script]
+	if len(candidates) == 1 {
+		// the script settles the language, there is nothing to compare;
+		// frequent words only add to its score
+		k := candidates[0]
+		langMatches[k] += scriptCountFactor * scriptLetters(counts, script)
+		if v, ok := frequentWordSets[k]; ok && frequent {
+			matchWords(k, tokens(text), langMatches, v)
+		}
+		return langMatches
 	}
 
-	for k, v := range scripts {
-		matchScript(k, text, langMatches, v...)
+	var undeterminedCount, profiles int
+	for _, order := range model {
+		grams := sortedNgrams(text, order.N)
+		for _, k := range candidates {
+			if hasProfile(k, order.N) {
+				undeterminedCount += matchWith(k, grams, order, langMatches)
+			}
+		}
+	}
+	for _, k := range candidates {
+		if _, ok := langs[k]; ok {
+			profiles++
+		}
+	}
+	if profiles > 0 {
+		langMatches[undetermined] += undeterminedCount * undeterminedProfiles / profiles
 	}
 
-	smx := softMax(langMatches)
-	maxk := maxKey(langMatches)
-	return Info{maxk, smx[maxk], language.MustParse(maxk)}
+	for _, k := range candidates {
+		if v, ok := letters[k]; ok {
+			matchLetters(k, text, langMatches, v)
+		}
+	}
+
+	words := tokens(text)
+	for _, k := range candidates {
+		if v, ok := markers[k]; ok {
+			matchMarkers(k, words, langMatches, v)
+		}
+		if v, ok := evidence[k]; ok {
+			matchEvidence(k, words, langMatches, v, evidenceLetters[k])
+		}
+		if v, ok := frequentWordSets[k]; ok && frequent {
+			matchWords(k, words, langMatches, v)
+		}
+	}
+
+	for k, v := range sharedScripts {
+		if scriptCodeOf(k) == script {
+			matchSharedScript(text, langMatches, k, v)
+		}
+	}
+	return langMatches
 }
 
+// softMax turns scores into probabilities; the terms are summed in key order
+// so the same scores always give the same probabilities
 func softMax(mapping map[string]int) map[string]float64 {
+	keys := make([]string, 0, len(mapping))
+	for k := range mapping {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	softMaxMap := make(map[string]float64)
 	var denom float64
 	overflowed := false
-	for _, v := range mapping {
+	for _, k := range keys {
+		v := mapping[k]
 		denom += math.Exp(float64(v) * rescale)
 		if v > expOverflow {
 			overflowed = true
@@ -141,11 +453,12 @@ func softMax(mapping map[string]int) map[string]float64 {
 	return softMaxMap
 }
 
+// maxKey returns the key with the highest value; see tieBefore for ties
 func maxKey(mapping map[string]int) string {
 	var max int
 	var key string
 	for k, v := range mapping {
-		if v > max {
+		if v > max || v == max && v > 0 && tieBefore(k, key) {
 			max = v
 			key = k
 		}
@@ -153,6 +466,18 @@ func maxKey(mapping map[string]int) string {
 	return key
 }
 
+// webShares holds DefaultPriors by language key
+var webShares = DefaultPriors.resolved()
+
+// tieBefore reports whether language a wins a tie with b: the one with the
+// larger share of the web wins, then the first in alphabetical order
+func tieBefore(a, b string) bool {
+	if webShares[a] != webShares[b] {
+		return webShares[a] > webShares[b]
+	}
+	return a < b
+}
+
 func matchScript(langName, text string, matches map[string]int, ranges ...*unicode.RangeTable) {
 	for _, r := range text {
 		if unicode.In(r, ranges...) {
@@ -161,73 +486,78 @@ func matchScript(langName, text string, matches map[string]int, ranges ...*unico
 	}
 }
 
-func matchWith(langName string, trigs []trigram, langProfile []string, matches map[string]int) {
-	var undeterminedCount int
-	prof := make(map[string]int)
-	for _, x := range langProfile {
-		prof[x] = 1
-	}
-
-	for _, trig := range trigs {
-		if _, exists := prof[trig.trigram]; exists {
-			matches[langName] += trig.count
-		} else {
-			undeterminedCount++
-			if (undeterminedCount % undeterminedRate) == 0 {
-				matches[undetermined]++
-			}
+func matchSharedScript(text string, matches map[string]int, table *unicode.RangeTable, langNames []string) {
+	best := langNames[0]
+	for _, l := range langNames[1:] {
+		if matches[l] > matches[best] {
+			best = l
 		}
 	}
+	matchScript(best, text, matches, table)
 }
 
-func countedTrigrams(text string) map[string]int {
-	trigrams := map[string]int{}
-	var txt []rune
-
+func matchLetters(langName, text string, matches map[string]int, table *unicode.RangeTable) {
 	for _, r := range text {
-		txt = append(txt, unicode.ToLower(toTrigramChar(r)))
-	}
-	txt = append(txt, ' ')
-
-	r1, r2 := ' ', txt[0]
-	var r3 rune
-	for i := 1; i < len(txt); i++ {
-		r3 = txt[i]
-		if !(r2 == ' ' && (r1 == ' ' || r3 == ' ')) {
-			trigram := []rune{r1, r2, r3}
-			if trigrams[string(trigram)] == 0 {
-				trigrams[string(trigram)] = 1
-			} else {
-				trigrams[string(trigram)]++
-			}
+		if unicode.Is(table, r) {
+			matches[langName] += letterCountFactor
 		}
-		r1, r2 = r2, r3
 	}
-
-	return trigrams
 }
 
-type trigram struct {
-	trigram string
-	count   int
+// matchEvidence adds points for each evidence word of a language found in
+// words, or takes evidencePenalty points away if there is none
+func matchEvidence(langName string, words []string, matches map[string]int, langEvidence []string, table *unicode.RangeTable) {
+	score := matches[langName]
+	matchMarkers(langName, words, matches, langEvidence)
+	for _, w := range words {
+		if table != nil && hasLetterFrom(w, table) {
+			matches[langName] += markerCountFactor
+		}
+	}
+	if matches[langName] > score || score == 0 {
+		return
+	}
+	if score > evidencePenalty {
+		matches[langName] = score - evidencePenalty
+	} else {
+		delete(matches, langName)
+	}
 }
 
-func sortedTrigs(s string) []trigram {
-	counterMap := countedTrigrams(s)
-	trigrams := make([]trigram, len(counterMap))
-
-	var i int
-	for tg, count := range counterMap {
-		trigrams[i] = trigram{tg, count}
-		i++
+// hasLetterFrom reports whether a word has a character from table used as a
+// letter: a letter anywhere, or a digit between two letters, so that "ma3a"
+// counts but "2024", "covid19" and "mp3" do not
+func hasLetterFrom(word string, table *unicode.RangeTable) bool {
+	if alphanumericWords[word] {
+		return false
 	}
-	sort.SliceStable(trigrams, func(i, j int) bool {
-		if trigrams[i].count == trigrams[j].count {
-			return trigrams[i].trigram < trigrams[j].trigram
+	runes := []rune(word)
+	for i, r := range runes {
+		if !unicode.Is(table, r) {
+			continue
 		}
-		return trigrams[i].count > trigrams[j].count
-	})
-	return trigrams
+		if unicode.IsLetter(r) {
+			return true
+		}
+		if i > 0 && i < len(runes)-1 && unicode.IsLetter(runes[i-1]) && unicode.IsLetter(runes[i+1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchMarkers(langName string, words []string, matches map[string]int, langMarkers []string) {
+	for _, w := range words {
+		for _, m := range langMarkers {
+			if w == m {
+				matches[langName] += markerCountFactor
+			}
+		}
+	}
+}
+
+func isWordSeparator(ch rune) bool {
+	return toTrigramChar(ch) == ' '
 }
 
 func toTrigramChar(ch rune) rune {
@@ -106,6 +106,342 @@ func TestPolishPhraseUDHR(t *testing.T) {
 		0.95)
 }
 
+func TestCzechPhraseUDHR(t *testing.T) {
+	text := "Všichni lidé rodí se svobodní a sobě rovní co do důstojnosti a práv"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"cs",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Czech",
+		"čeština")
+}
+
+func TestSlovakPhraseUDHR(t *testing.T) {
+	text := "Všetci ľudia sa rodia slobodní a sebe rovní, čo sa týka ich dôstojnosti a práv"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"sk",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Slovak",
+		"slovenčina")
+}
+
+func TestRomanianPhraseUDHR(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Toate ființele umane se nasc libere și egale în demnitate și în drepturi",
+		"ro",
+		0.95)
+}
+
+func TestCatalanPhraseUDHR(t *testing.T) {
+	text := "Tots els éssers humans neixen lliures i iguals en dignitat i en drets"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"ca",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Catalan",
+		"català")
+}
+
+func TestGalicianPhrase(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"A miña nai mercou pan e leite na panadaría da esquina",
+		"gl",
+		0.95)
+}
+
+func TestSlovenianPhraseUDHR(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Vsi ljudje se rodijo svobodni in imajo enako dostojanstvo in enake pravice",
+		"sl",
+		0.95)
+}
+
+func TestCroatianPhraseUDHR(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Sva ljudska bića rađaju se slobodna i jednaka u dostojanstvu i pravima",
+		"hr",
+		0.95)
+}
+
+func TestCzechSlovakDiscrimination(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Každý má právo na život, svobodu a osobní bezpečnost",
+		"cs",
+		0.75)
+
+	ensureClassifiedWithConfidence(
+		t,
+		"Každý má právo na život, slobodu a osobnú bezpečnosť",
+		"sk",
+		0.75)
+}
+
+func TestCatalanSpanishDiscrimination(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Tot individu té dret a la vida, a la llibertat i a la seguretat de la seva persona",
+		"ca",
+		0.75)
+
+	ensureClassifiedWithConfidence(
+		t,
+		"Todo individuo tiene derecho a la vida, a la libertad y a la seguridad de su persona",
+		"es",
+		0.75)
+}
+
+func TestGalicianPortugueseDiscrimination(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Galicia é unha terra de montes, ríos e praias, onde chove moito no inverno",
+		"gl",
+		0.75)
+
+	ensureClassifiedWithConfidence(
+		t,
+		"A Galiza é uma terra de montes, rios e praias, onde chove muito no inverno",
+		"pt",
+		0.75)
+}
+
+func TestSlovenianCroatianDiscrimination(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Vsakdo ima pravico do življenja, prostosti in osebne varnosti",
+		"sl",
+		0.75)
+
+	ensureClassifiedWithConfidence(
+		t,
+		"Svatko ima pravo na život, slobodu i osobnu sigurnost",
+		"hr",
+		0.75)
+}
+
+func TestIndonesianPhraseUDHR(t *testing.T) {
+	text := "Semua orang dilahirkan merdeka dan mempunyai martabat dan hak-hak yang sama"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"id",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Indonesian",
+		"Indonesia")
+}
+
+func TestMalayPhraseUDHR(t *testing.T) {
+	text := "Semua manusia dilahirkan bebas dan samarata dari segi kemuliaan dan hak-hak"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"ms",
+		0.75)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Malay",
+		"Melayu")
+}
+
+func TestIndonesianMalayDiscrimination(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Saya tidak bisa datang karena harus bekerja sampai sore",
+		"id",
+		0.95)
+
+	ensureClassifiedWithConfidence(
+		t,
+		"Saya tidak boleh datang kerana perlu bekerja sehingga petang",
+		"ms",
+		0.95)
+
+	ensureClassifiedWithConfidence(
+		t,
+		"Pemerintah akan membangun rumah sakit baru di kota ini",
+		"id",
+		0.75)
+
+	ensureClassifiedWithConfidence(
+		t,
+		"Kerajaan akan membina hospital baharu di bandar ini",
+		"ms",
+		0.75)
+}
+
+func TestSwahiliPhraseUDHR(t *testing.T) {
+	text := "Watu wote wamezaliwa huru, hadhi na haki zao ni sawa"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"sw",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Swahili",
+		"Kiswahili")
+}
+
+func TestYorubaPhraseUDHR(t *testing.T) {
+	text := "Gbogbo ènìyàn ni a bí ní òmìnira; iyì àti ẹ̀tọ́ kọ̀ọ̀kan sì dọ́gba"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"yo",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Yoruba",
+		"Èdè Yorùbá")
+}
+
+func TestYorubaPhraseWithoutDiacritics(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Gbogbo eniyan ni a bi ni ominira; iyi ati eto kookan si dogba",
+		"yo",
+		0.75)
+}
+
+func TestHausaPhraseUDHR(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Dukkan ’yan-adam an haife su ne da ’yanci da martaba da hakkoki daidai da kowa",
+		"ha",
+		0.95)
+}
+
+func TestZuluPhraseUDHR(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Bonke abantu bazalwa bekhululekile futhi belingana ngesithunzi nangamalungelo",
+		"zu",
+		0.95)
+}
+
+func TestSomaliPhraseUDHR(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Dadka oo dhan waxay dhashaan iyagoo xor ah kuna siman xagga sharafta iyo xuquuqda",
+		"so",
+		0.95)
+}
+
+func TestTurkishPhraseUDHR(t *testing.T) {
+	text := "Bütün insanlar hür, haysiyet ve haklar bakımından eşit doğarlar"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"tr",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Turkish",
+		"Türkçe")
+}
+
+func TestAzerbaijaniPhraseUDHR(t *testing.T) {
+	text := "Bütün insanlar ləyaqət və hüquqlarına görə azad və bərabər doğulurlar"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"az",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Azerbaijani",
+		"azərbaycan")
+}
+
+func TestTurkishAzerbaijaniDiscrimination(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Bugün hava çok güzel, dışarı çıkıp yürüyüş yapalım mı?",
+		"tr",
+		0.95)
+
+	ensureClassifiedWithConfidence(
+		t,
+		"Bu gün hava çox gözəldir, çölə çıxıb gəzək?",
+		"az",
+		0.95)
+}
+
+func TestUzbekLatinPhraseUDHR(t *testing.T) {
+	text := "Barcha odamlar erkin, qadr-qimmat va huquqlarda teng boʻlib tugʻiladilar"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"uz",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Uzbek (Latin)",
+		"o‘zbek")
+}
+
+func TestUzbekCyrillicPhraseUDHR(t *testing.T) {
+	text := "Барча одамлар эркин, қадр-қиммат ва ҳуқуқларда тенг бўлиб туғиладилар"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"uz",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Uzbek (Cyrillic)",
+		"ўзбекча")
+}
+
 func TestPunjabiPhrase(t *testing.T) {
 	text := "ਮੇਰਾ ਨਾਮ ਭਰਤ ਹੈ."
 	lang := "ਪੰਜਾਬੀ"
@@ -234,6 +570,91 @@ func TestBanglaPhrase(t *testing.T) {
 		lang)
 }
 
+func TestAssamesePhrase(t *testing.T) {
+	text := "সকলো মানুহ মুক্ত আৰু সমান মৰ্যদা আৰু অধিকাৰ লৈ জন্মগ্ৰহণ কৰে"
+	lang := "অসমীয়া"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"as",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Assamese",
+		lang)
+}
+
+func TestOdiaPhrase(t *testing.T) {
+	text := "ସମସ୍ତ ମଣିଷ ଜନ୍ମକାଳରୁ ସ୍ୱାଧୀନ"
+	lang := "ଓଡ଼ିଆ"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"or",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Odia",
+		lang)
+}
+
+func TestMalayalamPhrase(t *testing.T) {
+	text := "മനുഷ്യരെല്ലാവരും തുല്യാവകാശങ്ങളോടും"
+	lang := "മലയാളം"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"ml",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Malayalam",
+		lang)
+}
+
+func TestSinhalaPhrase(t *testing.T) {
+	text := "සියලු මනුෂ්‍යයෝ නිදහස්ව උපත ලබා ඇත"
+	lang := "සිංහල"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"si",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Sinhala",
+		lang)
+}
+
+func TestTibetanPhrase(t *testing.T) {
+	text := "འགྲོ་བ་མིའི་རིགས་རྒྱུད་ཡོངས་ལ"
+	lang := "བོད་སྐད་"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"bo",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Tibetan",
+		lang)
+}
+
 func TestHindiPhrase(t *testing.T) {
 	text := "ब तक लगातार चल रहा है। इसका प्रसारण प्रत्येक शनिवार और रविवार को रात 10 बजे होता है। इसका पुनः प्रसारण सोनी पल चैनल पर रात 9 बजे होता"
 	lang := "हिन्दी"
@@ -318,6 +739,116 @@ func TestThaiPhrase(t *testing.T) {
 		lang)
 }
 
+func TestKhmerPhrase(t *testing.T) {
+	text := "មនុស្សទាំងអស់កើតមកមានសេរីភាព"
+	lang := "ខ្មែរ"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"km",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Khmer",
+		lang)
+}
+
+func TestLaoPhrase(t *testing.T) {
+	text := "ມະນຸດທຸກຄົນເກີດມາມີສິດເສລີພາບ"
+	lang := "ລາວ"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"lo",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Lao",
+		lang)
+}
+
+func TestBurmesePhrase(t *testing.T) {
+	text := "လူတိုင်းသည် တူညီလွတ်လပ်သော ဂုဏ်သိက္ခာဖြင့်"
+	lang := "မြန်မာ"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"my",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Burmese",
+		lang)
+}
+
+func TestAmharicPhrase(t *testing.T) {
+	text := "የሰው ልጅ ሁሉ ሲወለድ ነጻና በክብርና በመብትም እኩልነት ያለው ነው"
+	lang := "አማርኛ"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"am",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Amharic",
+		lang)
+}
+
+func TestTigrinyaPhrase(t *testing.T) {
+	text := "ኩሎም ሰባት ብማዕረ ክብርን መሰልን ነጻ ኮይኖም እዮም ዝውለዱ"
+	lang := "ትግርኛ"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"ti",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Tigrinya",
+		lang)
+}
+
+func TestEthiopicScriptDefaultsToAmharic(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"ሀሁሂ",
+		"am",
+		0.75)
+}
+
+func TestGeorgianPhrase(t *testing.T) {
+	text := "ყველა ადამიანი იბადება თავისუფალი"
+	lang := "ქართული"
+
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"ka",
+		0.95)
+
+	ensureClassifiedTextNamed(
+		t,
+		text,
+		"Georgian",
+		lang)
+}
+
 func TestArmenianPhrase(t *testing.T) {
 	text := "ըստ Գրիգորյան օրացույցի"
 	lang := "հայերեն"
@@ -352,6 +883,12 @@ func TestSerbianLatinPhrase(t *testing.T) {
 		lang)
 }
 
+func TestSerboCroatianNeedsCroatianWordsForCroatian(t *testing.T) {
+	assert.Equal(t, "sr-Latn", FromString("ljudi ne znaju jer me uglavnom vide").Tag().String())
+	assert.Equal(t, "hr", FromString("ljudi ne znaju gdje me uglavnom vide").LanguageCode())
+	assert.Equal(t, "hr", FromString("tko zna zašto je to tako").LanguageCode())
+}
+
 func TestSerbianCyrillicPhrase(t *testing.T) {
 	text := "Код животиња су ове реакције посебно важне при зарастању рана"
 	lang := "српски"
@@ -471,6 +1008,83 @@ func TestKannadaPhrase(t *testing.T) {
 		lang)
 }
 
+func TestRomanizedHindiPhrase(t *testing.T) {
+	text := "mujhe nahi pata ki woh kab aayega"
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"hi",
+		0.95)
+
+	assert.Equal(t, "hi-Latn", FromString(text).Tag().String())
+}
+
+func TestArabiziPhrase(t *testing.T) {
+	text := "ezayak ya 7abibi, 3amel eh?"
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"ar",
+		0.95)
+
+	assert.Equal(t, "ar-Latn", FromString(text).Tag().String())
+}
+
+func TestPinyinPhrase(t *testing.T) {
+	ensureClassifiedWithConfidence(
+		t,
+		"Wǒ xiǎng qù Běijīng lǚyóu",
+		"zh",
+		0.95)
+
+	ensureClassifiedWithConfidence(
+		t,
+		"wo xiang qu beijing lvyou, ni ne?",
+		"zh",
+		0.85)
+
+	assert.Equal(t, "zh-Latn", FromString("wo xiang qu beijing lvyou, ni ne?").Tag().String())
+}
+
+func TestRomajiPhrase(t *testing.T) {
+	text := "arigatou gozaimasu, mata ashita"
+	ensureClassifiedWithConfidence(
+		t,
+		text,
+		"ja",
+		0.95)
+
+	assert.Equal(t, "ja-Latn", FromString(text).Tag().String())
+}
+
+func TestShortLatinPhrasesAreNotRomanized(t *testing.T) {
+	texts := []string{
+		"merci beaucoup", "bonjour", "ciao bella", "grazie mille", "come stai",
+		"hi", "hello world", "see you later", "hola amigo", "buenos dias", "que tal",
+	}
+	for _, text := range texts {
+		assert.NotContains(t, []string{"ar", "hi", "ja", "zh"}, FromString(text).LanguageCode(), text)
+	}
+}
+
+func TestArabiziDigitsNeedLetters(t *testing.T) {
+	table := evidenceLetters["ar-Latn"]
+
+	assert.True(t, hasLetterFrom("ma3a", table))
+	assert.True(t, hasLetterFrom("ya3ni", table))
+	assert.False(t, hasLetterFrom("2024", table))
+	assert.False(t, hasLetterFrom("habibi", table))
+	assert.False(t, hasLetterFrom("covid19", table))
+	assert.False(t, hasLetterFrom("h2o", table))
+	assert.False(t, hasLetterFrom("mp3", table))
+}
+
+func TestAlphanumericWordsAreNotArabizi(t *testing.T) {
+	for _, text := range []string{"covid19 update for h2o", "covid19", "h2o", "mp3", "download the mp3 now"} {
+		assert.NotEqual(t, "ar", FromString(text).LanguageCode(), text)
+	}
+}
+
 func TestNonsense(t *testing.T) {
 	text := "wep lvna eeii vl jkk azc nmn iuah ppl zccl c%l aa1z"
 	ensureClassifiedWithConfidence(
@@ -499,3 +1113,50 @@ func ensureClassifiedTextNamed(t *testing.T, text string, expectedEnglishName st
 	assert.Equal(t, expectedEnglishName, info.LanguageName(), "Wrong language name: "+text)
 	assert.Equal(t, expectedSelfName, info.SelfName(), "Wrong self lang name: "+text)
 }
+
+func benchmarkFromString(b *testing.B, text string) {
+	for i := 0; i < b.N; i++ {
+		FromString(text)
+	}
+}
+
+func BenchmarkFromStringLatin(b *testing.B) {
+	benchmarkFromString(b, "We hold these truths to be self-evident, that all men are created equal, that they are endowed by their Creator with certain unalienable Rights")
+}
+
+func BenchmarkFromStringCyrillic(b *testing.B) {
+	benchmarkFromString(b, "Все люди рождаются свободными и равными в своем достоинстве и правах. Они наделены разумом и совестью")
+}
+
+func BenchmarkFromStringGreek(b *testing.B) {
+	benchmarkFromString(b, "Όλοι οι άνθρωποι γεννιούνται ελεύθεροι και ίσοι στην αξιοπρέπεια και τα δικαιώματα")
+}
+
+func BenchmarkFromStringThai(b *testing.B) {
+	benchmarkFromString(b, "มนุษย์ทั้งหลายเกิดมามีอิสระและเสมอภาคกันในเกียรติศักดิ์และสิทธิ")
+}
+
+func BenchmarkFromStringJapanese(b *testing.B) {
+	benchmarkFromString(b, "すべての人間は、生まれながらにして自由であり、かつ、尊厳と権利とについて平等である")
+}
+
+func TestTiesGoToTheMoreCommonLanguage(t *testing.T) {
+	assert.Equal(t, "en", maxKey(map[string]int{"ca": 5, "en": 5, "tr": 5, "und": 1}))
+	assert.Equal(t, "sr-Latn", maxKey(map[string]int{"sr-Latn": 2, "sl": 1, "so": 2}))
+	assert.Equal(t, "so", maxKey(map[string]int{"zu": 2, "so": 2}))
+	assert.Equal(t, "en", FromString("I am here").LanguageCode())
+}
+
+func TestShortPhrasesKeepTheirLanguage(t *testing.T) {
+	tests := map[string]string{
+		"hello world":          "en",
+		"I am here":            "en",
+		"where is the station": "en",
+		"how are you":          "en",
+		"obrigado pela ajuda":  "pt",
+		"onde fica a estação":  "pt",
+	}
+	for text, lang := range tests {
+		assert.Equal(t, lang, FromString(text).LanguageCode(), text)
+	}
+}
@@ -0,0 +1,292 @@
+package getlang
+
+import (
+	"encoding/json"
+	"golang.org/x/net/html"
+	"golang.org/x/text/language"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// declaredBonus is added to the score of the language a document declares
+// when a Detector trusts declarations
+const declaredBonus int = 8
+
+// hiddenElements hold text that is never rendered as page content
+var hiddenElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"template": true,
+	"svg":      true,
+	"math":     true,
+}
+
+// HTMLInfo is the language detection result for an HTML or XML document
+type HTMLInfo struct {
+	Info
+	langAttr        language.Tag
+	contentLanguage language.Tag
+}
+
+// htmlInfoJSON is the JSON form of HTMLInfo; the MarshalJSON of the embedded
+// Info would otherwise leave out the declarations
+//
+// Declared is written for readers of the JSON and ignored when it is decoded
+type htmlInfoJSON struct {
+	Info            Info   `json:"info"`
+	LangAttr        string `json:"langAttr,omitempty"`
+	ContentLanguage string `json:"contentLanguage,omitempty"`
+	Declared        string `json:"declared,omitempty"`
+}
+
+// MarshalJSON writes the Info of the document next to its declared languages
+func (info HTMLInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(htmlInfoJSON{
+		Info:            info.Info,
+		LangAttr:        info.langAttr.String(),
+		ContentLanguage: info.contentLanguage.String(),
+		Declared:        info.Declared().String(),
+	})
+}
+
+// UnmarshalJSON reads an object written by MarshalJSON
+func (info *HTMLInfo) UnmarshalJSON(data []byte) error {
+	var v htmlInfoJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	langAttr, err := parseDeclaredTag(v.LangAttr)
+	if err != nil {
+		return err
+	}
+	contentLanguage, err := parseDeclaredTag(v.ContentLanguage)
+	if err != nil {
+		return err
+	}
+	*info = HTMLInfo{Info: v.Info, langAttr: langAttr, contentLanguage: contentLanguage}
+	return nil
+}
+
+// parseDeclaredTag reads a tag written by MarshalJSON; a missing tag is und
+func parseDeclaredTag(s string) (language.Tag, error) {
+	if s == "" {
+		return language.Und, nil
+	}
+	return language.Parse(s)
+}
+
+// LangAttr returns the language of the document's lang or xml:lang attribute,
+// or und when there is none
+func (info HTMLInfo) LangAttr() language.Tag {
+	return info.langAttr
+}
+
+// ContentLanguage returns the first language of the document's
+// <meta http-equiv="content-language">, or und when there is none
+func (info HTMLInfo) ContentLanguage() language.Tag {
+	return info.contentLanguage
+}
+
+// Declared returns the language the document declares; the lang attribute
+// takes precedence over the meta element
+func (info HTMLInfo) Declared() language.Tag {
+	if info.langAttr != language.Und {
+		return info.langAttr
+	}
+	return info.contentLanguage
+}
+
+// Consistent reports whether the detected language agrees with the declared one
+//
+// Documents without a declaration are consistent
+func (info HTMLInfo) Consistent() bool {
+	declared := info.Declared()
+	if declared == language.Und {
+		return true
+	}
+	declaredBase, _ := declared.Base()
+	detectedBase, _ := info.Tag().Base()
+	return declaredBase == detectedBase
+}
+
+// FromHTML detects the language of the visible text of an HTML or XML document
+//
+// Markup, scripts and styles are skipped. The document is decoded from the
+// charset its <meta> element or XML declaration names, or from the one
+// FromBytes detects when it names none. This function will read all bytes
+// until an EOF is reached
+func FromHTML(reader io.Reader) (HTMLInfo, error) {
+	return defaultDetector.FromHTML(reader)
+}
+
+// FromHTML detects the language of the visible text of an HTML or XML document
+//
+// When the Detector trusts declarations, the declared language gets a head start
+func (d Detector) FromHTML(reader io.Reader) (HTMLInfo, error) {
+	b, err := ioutil.ReadAll(reader)
+	cs, text := decodeDocument(b)
+	visible, result := extractHTML(text)
+
+	prior := ""
+	if d.TrustDeclared {
+		prior, _ = languageKey(result.Declared())
+	}
+	info, detectErr := d.detect(visible, prior)
+	info.charset = cs.name
+	info.extractor = HTML.Name()
+	result.Info = info
+
+	if err == nil {
+		err = detectErr
+	}
+	return result, err
+}
+
+func extractHTML(text string) (string, HTMLInfo) {
+	result := HTMLInfo{langAttr: language.Und, contentLanguage: language.Und}
+	var sb strings.Builder
+	var hidden int
+
+	z := html.NewTokenizer(strings.NewReader(text))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return sb.String(), result
+		case html.TextToken:
+			if hidden == 0 {
+				sb.Write(z.Text())
+				sb.WriteByte(' ')
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			if hiddenElements[tok.Data] && tok.Type == html.StartTagToken {
+				hidden++
+			}
+			readDeclarations(tok, &result)
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			if hiddenElements[string(name)] && hidden > 0 {
+				hidden--
+			}
+		}
+	}
+}
+
+func readDeclarations(tok html.Token, result *HTMLInfo) {
+	var httpEquiv, content string
+	for _, attr := range tok.Attr {
+		switch strings.ToLower(attr.Key) {
+		case "lang", "xml:lang":
+			if result.langAttr == language.Und {
+				result.langAttr = parseDeclared(attr.Val)
+			}
+		case "http-equiv":
+			httpEquiv = strings.ToLower(attr.Val)
+		case "content":
+			content = attr.Val
+		}
+	}
+
+	if tok.Data == "meta" && httpEquiv == "content-language" && result.contentLanguage == language.Und {
+		result.contentLanguage = parseDeclared(strings.Split(content, ",")[0])
+	}
+}
+
+func parseDeclared(value string) language.Tag {
+	tag, err := language.Parse(strings.TrimSpace(value))
+	if err != nil {
+		return language.Und
+	}
+	return tag
+}
+
+// Supports reports whether getlang can detect the language of tag, such as
+// "pt-BR" or "sr-Cyrl"; it ignores the region
+func Supports(tag language.Tag) bool {
+	_, ok := languageKey(tag)
+	return ok
+}
+
+// languageKey finds the supported language for a tag, preferring the one written
+// in the same script and then the one without a script subtag
+func languageKey(tag language.Tag) (string, bool) {
+	if tag == language.Und {
+		return "", false
+	}
+	base, _ := tag.Base()
+	script, _ := tag.Script()
+
+	var found string
+	for _, s := range supported().langs {
+		if s.base != base {
+			continue
+		}
+		if s.script == script {
+			return s.key, true
+		}
+		if found == "" || s.key == base.String() {
+			found = s.key
+		}
+	}
+	return found, found != ""
+}
+
+// supportedLanguage is a language key with its parsed base and script
+type supportedLanguage struct {
+	key    string
+	base   language.Base
+	script language.Script
+}
+
+// languageIndex holds every language getlang detects; it is built once, on
+// first use, and never changed
+type languageIndex struct {
+	keys  []string
+	set   map[string]bool
+	langs []supportedLanguage
+}
+
+var (
+	indexOnce sync.Once
+	index     languageIndex
+)
+
+// supported returns the language index, building it on first use
+func supported() *languageIndex {
+	indexOnce.Do(func() {
+		index.set = make(map[string]bool)
+		for k := range langs {
+			index.set[k] = true
+		}
+		for k := range scripts {
+			index.set[k] = true
+		}
+		for _, v := range sharedScripts {
+			for _, k := range v {
+				index.set[k] = true
+			}
+		}
+
+		for k := range index.set {
+			index.keys = append(index.keys, k)
+		}
+		sort.Strings(index.keys)
+
+		for _, k := range index.keys {
+			tag := language.MustParse(k)
+			base, _ := tag.Base()
+			script, _ := tag.Script()
+			index.langs = append(index.langs, supportedLanguage{key: k, base: base, script: script})
+		}
+	})
+	return &index
+}
+
+// supportedLanguages returns the key of every language getlang detects, in
+// alphabetical order; callers must not modify the slice
+func supportedLanguages() []string {
+	return supported().keys
+}
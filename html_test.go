@@ -0,0 +1,126 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"strings"
+	"testing"
+)
+
+const germanPage = `<!DOCTYPE html>
+<html lang="de-AT">
+<head>
+<meta charset="utf-8">
+<meta http-equiv="Content-Language" content="de, en">
+<title>Startseite</title>
+<style>body { font-family: sans-serif; background-color: white; }</style>
+<script>var navigationItems = ["home", "about", "contact"]; if (a < b) { render(); }</script>
+</head>
+<body class="main-content" data-tracking-identifier="homepage">
+<p>Alle Menschen sind frei und gleich an W&uuml;rde und Rechten geboren.</p>
+<svg><text>Chart</text></svg>
+</body>
+</html>`
+
+func TestFromHTML(t *testing.T) {
+	info, err := FromHTML(strings.NewReader(germanPage))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "de", info.LanguageCode())
+	assert.Equal(t, "UTF-8", info.Charset())
+	assert.Equal(t, "html", info.Extractor())
+	assert.Equal(t, "de-AT", info.LangAttr().String())
+	assert.Equal(t, "de", info.ContentLanguage().String())
+	assert.Equal(t, "de-AT", info.Declared().String())
+	assert.True(t, info.Consistent())
+}
+
+func TestFromHTMLRejectInvalid(t *testing.T) {
+	page := strings.Replace(germanPage, "W&uuml;rde", "W\xffürde", 1)
+	info, err := Detector{Invalid: RejectInvalid}.FromHTML(strings.NewReader(page))
+
+	assert.Equal(t, ErrInvalidText, err)
+	assert.Equal(t, "UTF-8", info.Charset())
+	assert.Equal(t, 1, info.InvalidCount())
+}
+
+func TestExtractHTMLSkipsMarkup(t *testing.T) {
+	text, _ := extractHTML(germanPage)
+
+	assert.Equal(t, "Startseite Alle Menschen sind frei und gleich an Würde und Rechten geboren.", words(text))
+}
+
+func TestFromHTMLInconsistentDeclaration(t *testing.T) {
+	page := `<html lang="en"><body><p>Tous les êtres humains naissent libres et égaux en dignité et en droits.</p></body></html>`
+	info, _ := FromHTML(strings.NewReader(page))
+
+	assert.Equal(t, "fr", info.LanguageCode())
+	assert.False(t, info.Consistent())
+}
+
+func TestFromHTMLMetaOnly(t *testing.T) {
+	page := `<html><head><meta http-equiv="content-language" content="ru"></head><body>статей на русском</body></html>`
+	info, _ := FromHTML(strings.NewReader(page))
+
+	assert.Equal(t, "und", info.LangAttr().String())
+	assert.Equal(t, "ru", info.Declared().String())
+	assert.True(t, info.Consistent())
+}
+
+func TestFromHTMLTrustDeclared(t *testing.T) {
+	page := `<div xml:lang="es">hola amigo</div>`
+
+	info, _ := FromHTML(strings.NewReader(page))
+	trusted, _ := Detector{TrustDeclared: true}.FromHTML(strings.NewReader(page))
+
+	assert.Equal(t, "es", trusted.LanguageCode())
+	assert.True(t, trusted.Confidence() > info.Confidence())
+}
+
+func TestFromHTMLTrustDeclaredNeedsText(t *testing.T) {
+	trusting := Detector{TrustDeclared: true}
+
+	info, _ := trusting.FromHTML(strings.NewReader(`<html lang="ru"><p>We hold these truths to be self-evident</p></html>`))
+	assert.Equal(t, "en", info.LanguageCode())
+	assert.Equal(t, "ru", info.Declared().String())
+	assert.False(t, info.Consistent())
+
+	info, _ = trusting.FromHTML(strings.NewReader(`<html lang="de"><p>2024 — 42 %</p></html>`))
+	assert.Equal(t, "und", info.LanguageCode())
+}
+
+func TestFromXML(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<article xml:lang="pl"><para>Wszyscy ludzie rodzą się wolni i równi w swojej godności i prawach.</para></article>`
+	info, _ := FromHTML(strings.NewReader(doc))
+
+	assert.Equal(t, "pl", info.LanguageCode())
+	assert.Equal(t, "pl", info.Declared().String())
+}
+
+func TestLanguageKey(t *testing.T) {
+	for tag, expected := range map[string]string{
+		"en-GB":   "en",
+		"sr":      "sr-Cyrl",
+		"sr-Latn": "sr-Latn",
+		"hi":      "hi",
+		"zh-TW":   "zh",
+		"uz":      "uz-Latn",
+	} {
+		key, ok := languageKey(parseDeclared(tag))
+		assert.True(t, ok, tag)
+		assert.Equal(t, expected, key, tag)
+	}
+
+	_, ok := languageKey(parseDeclared("xx-invalid-"))
+	assert.False(t, ok)
+}
+
+func TestSupports(t *testing.T) {
+	for _, tag := range []string{"en", "pt-BR", "sr-Cyrl", "sr", "hi-Latn", "zh-Hant"} {
+		assert.True(t, Supports(language.MustParse(tag)), tag)
+	}
+	for _, tag := range []string{"sv", "fa", "und"} {
+		assert.False(t, Supports(language.MustParse(tag)), tag)
+	}
+}
@@ -0,0 +1,98 @@
+package getlang
+
+import (
+	"golang.org/x/text/language"
+	"strings"
+)
+
+// iso6392B holds the ISO 639-2 bibliographic codes that differ from the
+// terminology codes
+var iso6392B = map[string]string{
+	"bod": "tib", "ces": "cze", "cym": "wel", "deu": "ger", "ell": "gre",
+	"eus": "baq", "fas": "per", "fra": "fre", "hye": "arm", "isl": "ice",
+	"kat": "geo", "mkd": "mac", "mri": "mao", "msa": "may", "mya": "bur",
+	"nld": "dut", "ron": "rum", "slk": "slo", "sqi": "alb", "zho": "chi",
+}
+
+// iso6392Only holds the individual languages and macrolanguages of ISO 639-2
+// without an ISO 639-1 code; every language with an ISO 639-1 code is also in
+// ISO 639-2
+var iso6392Only = codeSet(`
+	ace ach ada ady afh ain akk ale alt ang anp arc arn arp arw ast awa bal ban
+	bas bej bem bho bik bin bla bra bua bug byn cad car ceb chb chg chk chm chn
+	cho chp chr chy cop crh csb dak dar del den dgr din doi dsb dua dum dyu efi
+	egy eka elx enm ewo fan fat fil fon frm fro frr frs fur gaa gay gba gez gil
+	gmh goh gon gor got grb grc gsw gwi hai haw hil hit hmn hsb hup iba ilo inh
+	jbo jpr jrb kaa kab kac kam kaw kbd kha kho kmb kok kos kpe krc krl kru kum
+	kut lad lah lam lez lol loz lua lui lun luo lus mad mag mai mak man mas mdf
+	mdr men mga mic min mnc mni moh mos mus mwl mwr myv nap nds new nia niu nog
+	non nqo nso nwc nym nyn nyo nzi osa ota pag pal pam pap pau peo phn pon pro
+	raj rap rar rom rup sad sah sam sas sat scn sco sel sga shn sid sma smj smn
+	sms snk sog srn srr suk sus sux syc syr tem ter tet tig tiv tkl tlh tli tmh
+	tog tpi tsi tum tvl tyv udm uga umb und vai vot wal war was xal yao yap zap
+	zbl zen zgh zun zza
+`)
+
+func codeSet(codes string) map[string]bool {
+	set := make(map[string]bool)
+	for _, c := range strings.Fields(codes) {
+		set[c] = true
+	}
+	return set
+}
+
+// base returns the language subtag of the detected language, without the
+// replacements language.Tag makes for deprecated and overlong codes
+func (info Info) base() language.Base {
+	base, err := language.ParseBase(info.LanguageCode())
+	if err != nil {
+		base = language.MustParseBase(undetermined)
+	}
+	return base
+}
+
+// ISO6393 returns the ISO 639-3 code for the detected language, such as "eng"
+// or "und"
+func (info Info) ISO6393() string {
+	return info.base().ISO3()
+}
+
+// ISO6392T returns the ISO 639-2 terminology code for the detected language,
+// such as "deu"
+//
+// It is empty for languages that ISO 639-2 does not cover, such as Yue
+func (info Info) ISO6392T() string {
+	base := info.base()
+	if code := base.ISO3(); len(base.String()) == 2 || iso6392Only[code] {
+		return code
+	}
+	return ""
+}
+
+// ISO6392B returns the ISO 639-2 bibliographic code for the detected language,
+// such as "ger"
+//
+// It is the terminology code for all but twenty languages, and empty for
+// languages that ISO 639-2 does not cover
+func (info Info) ISO6392B() string {
+	code := info.ISO6392T()
+	if b, ok := iso6392B[code]; ok {
+		return b
+	}
+	return code
+}
+
+// BCP47 returns the BCP 47 tag of the detected language, with the script of
+// romanized languages and any region the Detector found, such as "hi-Latn" or
+// "pt-BR"
+//
+// Unlike Tag, it keeps the language subtag as detected, so Tagalog is "tl"
+// rather than "fil"
+func (info Info) BCP47() string {
+	_, script, region := info.langTag.Raw()
+	tag, err := language.Raw.Compose(info.base(), script, region)
+	if err != nil {
+		return info.langTag.String()
+	}
+	return tag.String()
+}
@@ -0,0 +1,61 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+func TestISO639Codes(t *testing.T) {
+	tests := []struct {
+		lang, iso6393, iso6392T, iso6392B, bcp47 string
+	}{
+		{"en", "eng", "eng", "eng", "en"},
+		{"de", "deu", "deu", "ger", "de"},
+		{"zh", "zho", "zho", "chi", "zh"},
+		{"tl", "tgl", "tgl", "tgl", "tl"},
+		{"he", "heb", "heb", "heb", "he"},
+		{"hi-Latn", "hin", "hin", "hin", "hi-Latn"},
+		{"ceb", "ceb", "ceb", "ceb", "ceb"},
+		{"haw", "haw", "haw", "haw", "haw"},
+		{"yue", "yue", "", "", "yue"},
+		{"yue-Hant", "yue", "", "", "yue-Hant"},
+		{"und", "und", "und", "und", "und"},
+	}
+	for _, tt := range tests {
+		info := Info{lang: tt.lang, langTag: language.MustParse(tt.lang)}
+		assert.Equal(t, tt.iso6393, info.ISO6393(), tt.lang)
+		assert.Equal(t, tt.iso6392T, info.ISO6392T(), tt.lang)
+		assert.Equal(t, tt.iso6392B, info.ISO6392B(), tt.lang)
+		assert.Equal(t, tt.bcp47, info.BCP47(), tt.lang)
+	}
+}
+
+func TestLanguageCodeWithoutISO6391(t *testing.T) {
+	info := Info{lang: "yue-Hant", langTag: language.MustParse("yue-Hant")}
+
+	assert.Equal(t, "yue", info.LanguageCode())
+}
+
+func TestBCP47KeepsRegion(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("Você pegou o ônibus ou o trem para chegar ao trabalho hoje de manhã?")
+
+	assert.Equal(t, "pt-BR", info.BCP47())
+	assert.Equal(t, "por", info.ISO6393())
+}
+
+func TestISO639OfDetectedText(t *testing.T) {
+	info := FromString("Ich möchte eine Tasse Kaffee mit Milch und Zucker, bitte")
+
+	assert.Equal(t, "deu", info.ISO6393())
+	assert.Equal(t, "deu", info.ISO6392T())
+	assert.Equal(t, "ger", info.ISO6392B())
+	assert.Equal(t, "de", info.BCP47())
+}
+
+func TestISO639OfZeroInfo(t *testing.T) {
+	var info Info
+
+	assert.Equal(t, "und", info.ISO6393())
+	assert.Equal(t, "und", info.ISO6392T())
+}
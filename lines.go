@@ -0,0 +1,71 @@
+package getlang
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// maxLineLength is the longest line DetectLines accepts
+const maxLineLength = 1024 * 1024
+
+// DetectLines detects the language of each non-blank line read from reader and
+// passes it to fn along with its line number, starting at 1
+func DetectLines(reader io.Reader, fn func(lineNo int, info Info)) error {
+	return defaultDetector.DetectLines(reader, fn)
+}
+
+// DetectLines detects the language of each non-blank line read from reader and
+// passes it to fn along with its line number, starting at 1
+//
+// When LineWindow is set, consecutive short lines are grouped until they hold
+// that many scored characters, and every line of a group gets the group's Info.
+// A blank line always ends a group. Lines rejected by RejectInvalid are
+// reported as undetermined
+func (d Detector) DetectLines(reader io.Reader, fn func(lineNo int, info Info)) error {
+	return d.DetectLineText(reader, func(lineNo int, _ string, info Info) {
+		fn(lineNo, info)
+	})
+}
+
+// DetectLineText is DetectLines with the text of each line, without its line
+// ending, passed to fn as well
+func (d Detector) DetectLineText(reader io.Reader, fn func(lineNo int, text string, info Info)) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+
+	var window []string
+	var first, chars int
+	flush := func() {
+		if len(window) == 0 {
+			return
+		}
+		info, _ := d.FromString(strings.Join(window, "\n"))
+		for i, line := range window {
+			fn(first+i, line, info)
+		}
+		window, chars = window[:0], 0
+	}
+
+	var lineNo int
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+
+		if len(window) == 0 {
+			first = lineNo
+		}
+		window = append(window, line)
+		chars += scoredChars(line)
+		if chars >= d.LineWindow {
+			flush()
+		}
+	}
+
+	flush()
+	return scanner.Err()
+}
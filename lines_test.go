@@ -0,0 +1,64 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const parallelCorpus = "Alle Menschen sind frei und gleich an Würde und Rechten geboren.\n" +
+	"\n" +
+	"Tous les êtres humains naissent libres et égaux en dignité et en droits.\r\n" +
+	"All human beings are born free and equal in dignity and rights."
+
+func TestDetectLines(t *testing.T) {
+	var lineNos []int
+	var codes []string
+	err := DetectLines(strings.NewReader(parallelCorpus), func(lineNo int, info Info) {
+		lineNos = append(lineNos, lineNo)
+		codes = append(codes, info.LanguageCode())
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 3, 4}, lineNos)
+	assert.Equal(t, []string{"de", "fr", "en"}, codes)
+}
+
+func TestDetectLinesWindow(t *testing.T) {
+	text := "Menschen\nWürde\nRechten\nBrüderlichkeit\n\nhumains\n"
+	detector := Detector{LineWindow: 20}
+
+	results := map[int]Info{}
+	err := detector.DetectLines(strings.NewReader(text), func(lineNo int, info Info) {
+		results[lineNo] = info
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(results))
+	assert.Equal(t, results[1], results[2])
+	assert.Equal(t, results[1], results[3])
+	assert.Equal(t, "de", results[4].LanguageCode())
+	assert.NotEqual(t, results[3].ScoredChars(), results[4].ScoredChars())
+	assert.Equal(t, 7, results[6].ScoredChars())
+}
+
+func TestDetectLinesTooLong(t *testing.T) {
+	long := strings.Repeat("a", maxLineLength+1)
+	err := DetectLines(strings.NewReader(long), func(int, Info) {})
+
+	assert.NotNil(t, err)
+}
+
+func TestDetectLineText(t *testing.T) {
+	texts := map[int]string{}
+	err := Detector{}.DetectLineText(strings.NewReader(parallelCorpus), func(lineNo int, text string, info Info) {
+		texts[lineNo] = text
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[int]string{
+		1: "Alle Menschen sind frei und gleich an Würde und Rechten geboren.",
+		3: "Tous les êtres humains naissent libres et égaux en dignité et en droits.",
+		4: "All human beings are born free and equal in dignity and rights.",
+	}, texts)
+}
@@ -0,0 +1,70 @@
+package locale
+
+import (
+	"encoding/xml"
+	"golang.org/x/text/language"
+	"io"
+	"strings"
+)
+
+// androidEscapes undoes the backslash escapes of Android string resources
+var androidEscapes = strings.NewReplacer(`\'`, `'`, `\"`, `"`, `\n`, "\n", `\t`, "\t", `\@`, `@`, `\?`, `?`, `\\`, `\`)
+
+// ParseAndroid parses an Android strings.xml resource file
+//
+// Android keeps the locale in the directory name, so the locale is und; set it
+// with LocaleFromPath. Strings marked translatable="false" are skipped, and each
+// item of a string-array or plurals becomes its own Entry
+func ParseAndroid(reader io.Reader) (File, error) {
+	f := File{Locale: language.Und, SourceLocale: language.Und}
+	dec := xml.NewDecoder(reader)
+
+	var name string
+	var text strings.Builder
+	var inString, skip bool
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return f, nil
+		}
+		if err != nil {
+			return f, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "string", "string-array", "plurals":
+				name = attr(t.Attr, "name")
+				skip = attr(t.Attr, "translatable") == "false"
+				inString = t.Name.Local == "string"
+				text.Reset()
+			case "item":
+				inString = true
+				text.Reset()
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "string", "item":
+				if inString && !skip && text.Len() > 0 {
+					f.Entries = append(f.Entries, Entry{Key: name, Target: androidText(text.String())})
+				}
+				inString = false
+			}
+		case xml.CharData:
+			if inString {
+				text.Write(t)
+			}
+		}
+	}
+}
+
+// androidText unescapes a resource string and drops the quotes that keep its
+// whitespace
+func androidText(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	return androidEscapes.Replace(s)
+}
@@ -0,0 +1,35 @@
+package locale
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const androidStrings = `<?xml version="1.0" encoding="utf-8"?>
+<resources>
+    <string name="app_name" translatable="false">Acme</string>
+    <string name="greeting">"  Bonjour, l\'équipe \@acme  "</string>
+    <string-array name="days">
+        <item>lundi</item>
+        <item>mardi</item>
+    </string-array>
+    <plurals name="files">
+        <item quantity="one">%d fichier</item>
+        <item quantity="other">%d fichiers</item>
+    </plurals>
+</resources>`
+
+func TestParseAndroid(t *testing.T) {
+	f, err := ParseAndroid(strings.NewReader(androidStrings))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "und", f.Locale.String())
+	assert.Equal(t, []Entry{
+		{Key: "greeting", Target: "  Bonjour, l'équipe @acme  "},
+		{Key: "days", Target: "lundi"},
+		{Key: "days", Target: "mardi"},
+		{Key: "files", Target: "%d fichier"},
+		{Key: "files", Target: "%d fichiers"},
+	}, f.Entries)
+}
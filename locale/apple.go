@@ -0,0 +1,156 @@
+package locale
+
+import (
+	"fmt"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/language"
+	"golang.org/x/text/transform"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// ParseStrings parses an Apple .strings file of "key" = "value"; pairs
+//
+// The file may be UTF-8 or UTF-16 with a byte order mark. Apple keeps the
+// locale in the .lproj directory name, so the locale is und; set it with
+// LocaleFromPath
+func ParseStrings(reader io.Reader) (File, error) {
+	f := File{Locale: language.Und, SourceLocale: language.Und}
+	b, err := ioutil.ReadAll(transform.NewReader(reader, unicode.BOMOverride(unicode.UTF8.NewDecoder())))
+	if err != nil {
+		return f, err
+	}
+
+	s := &stringsScanner{text: string(b)}
+	for {
+		key, ok, err := s.quoted()
+		if err != nil || !ok {
+			return f, err
+		}
+		if !s.expect('=') {
+			return f, fmt.Errorf("locale: expected = after %q", key)
+		}
+		value, ok, err := s.quoted()
+		if err != nil {
+			return f, err
+		}
+		if !ok || !s.expect(';') {
+			return f, fmt.Errorf("locale: expected \"value\"; after %q", key)
+		}
+		f.Entries = append(f.Entries, Entry{Key: key, Target: value})
+	}
+}
+
+type stringsScanner struct {
+	text string
+	pos  int
+}
+
+// skip passes over whitespace and comments
+func (s *stringsScanner) skip() {
+	for s.pos < len(s.text) {
+		rest := s.text[s.pos:]
+		switch {
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest, "*/")
+			if end < 0 {
+				s.pos = len(s.text)
+				return
+			}
+			s.pos += end + 2
+		case strings.HasPrefix(rest, "//"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				s.pos = len(s.text)
+				return
+			}
+			s.pos += end + 1
+		case strings.ContainsRune(" \t\r\n", rune(rest[0])):
+			s.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (s *stringsScanner) expect(c byte) bool {
+	s.skip()
+	if s.pos < len(s.text) && s.text[s.pos] == c {
+		s.pos++
+		return true
+	}
+	return false
+}
+
+// quoted reads the next quoted string; ok is false at the end of the file
+func (s *stringsScanner) quoted() (string, bool, error) {
+	s.skip()
+	if s.pos == len(s.text) {
+		return "", false, nil
+	}
+	if s.text[s.pos] != '"' {
+		return "", false, fmt.Errorf("locale: expected a quoted string at offset %d", s.pos)
+	}
+
+	var sb strings.Builder
+	for i := s.pos + 1; i < len(s.text); i++ {
+		c := s.text[i]
+		switch {
+		case c == '"':
+			s.pos = i + 1
+			return sb.String(), true, nil
+		case c == '\\' && i+1 < len(s.text):
+			i++
+			switch s.text[i] {
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			case 'r':
+				sb.WriteByte('\r')
+			case 'U', 'u':
+				r, size, ok := unicodeEscape(s.text[i-1:])
+				if !ok {
+					return "", false, fmt.Errorf("locale: invalid \\U escape at offset %d", i-1)
+				}
+				sb.WriteRune(r)
+				i += size - 2
+			default:
+				sb.WriteByte(s.text[i])
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", false, fmt.Errorf("locale: unterminated string at offset %d", s.pos)
+}
+
+// unicodeEscape decodes a \UXXXX escape at the start of text, joining a UTF-16
+// surrogate pair written as two escapes; size is the number of bytes read
+func unicodeEscape(text string) (rune, int, bool) {
+	r, ok := hex4(text)
+	if !ok {
+		return 0, 0, false
+	}
+	if utf16.IsSurrogate(r) {
+		if low, ok := hex4(text[6:]); ok {
+			if pair := utf16.DecodeRune(r, low); pair != utf8.RuneError {
+				return pair, 12, true
+			}
+		}
+	}
+	return r, 6, true
+}
+
+// hex4 reads the four hex digits of a \UXXXX or \uXXXX escape
+func hex4(text string) (rune, bool) {
+	if len(text) < 6 || text[0] != '\\' || (text[1] != 'U' && text[1] != 'u') {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(text[2:6], 16, 16)
+	return rune(n), err == nil
+}
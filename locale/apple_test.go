@@ -0,0 +1,54 @@
+package locale
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding/unicode"
+	"strings"
+	"testing"
+)
+
+const appleStrings = `/* Title of the settings screen */
+"settings.title" = "Einstellungen";
+
+// Shown after saving
+"saved" = "Gespeichert: \"%@\"\nDanke!";
+`
+
+func TestParseStrings(t *testing.T) {
+	f, err := ParseStrings(strings.NewReader(appleStrings))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Entry{
+		{Key: "settings.title", Target: "Einstellungen"},
+		{Key: "saved", Target: "Gespeichert: \"%@\"\nDanke!"},
+	}, f.Entries)
+}
+
+func TestParseStringsUTF16(t *testing.T) {
+	utf16, _ := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewEncoder().String(appleStrings)
+	f, err := ParseStrings(strings.NewReader(utf16))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(f.Entries))
+	assert.Equal(t, "Einstellungen", f.Entries[0].Target)
+}
+
+func TestParseStringsUnicodeEscapes(t *testing.T) {
+	f, err := ParseStrings(strings.NewReader(`"cafe" = "Caf\U00E9 \u00fcber \UD83D\UDE00";`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Entry{{Key: "cafe", Target: "Café über 😀"}}, f.Entries)
+}
+
+func TestParseStringsInvalid(t *testing.T) {
+	for _, text := range []string{
+		`"key" "value";`,
+		`"key" = "value"`,
+		`"key" = "unterminated;`,
+		`key = "value";`,
+		`"key" = "\U00G9";`,
+	} {
+		_, err := ParseStrings(strings.NewReader(text))
+		assert.NotNil(t, err, text)
+	}
+}
@@ -0,0 +1,41 @@
+package locale
+
+import (
+	"encoding/json"
+	"golang.org/x/text/language"
+	"io"
+	"sort"
+	"strings"
+)
+
+// ParseARB parses a Flutter Application Resource Bundle
+//
+// The locale comes from the @@locale field. Metadata keys starting with @ are
+// skipped
+func ParseARB(reader io.Reader) (File, error) {
+	f := File{Locale: language.Und, SourceLocale: language.Und}
+
+	var bundle map[string]interface{}
+	if err := json.NewDecoder(reader).Decode(&bundle); err != nil {
+		return f, err
+	}
+
+	if locale, ok := bundle["@@locale"].(string); ok {
+		f.Locale = parseLocale(locale)
+	}
+
+	keys := make([]string, 0, len(bundle))
+	for k := range bundle {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		value, ok := bundle[k].(string)
+		if !ok || strings.HasPrefix(k, "@") {
+			continue
+		}
+		f.Entries = append(f.Entries, Entry{Key: k, Target: value})
+	}
+	return f, nil
+}
@@ -0,0 +1,30 @@
+package locale
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const arbFile = `{
+  "@@locale": "pt_BR",
+  "helloWorld": "Olá mundo",
+  "@helloWorld": {"description": "The conventional newborn programmer greeting"},
+  "itemCount": "{count, plural, =1{1 item} other{{count} itens}}"
+}`
+
+func TestParseARB(t *testing.T) {
+	f, err := ParseARB(strings.NewReader(arbFile))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "pt-BR", f.Locale.String())
+	assert.Equal(t, []Entry{
+		{Key: "helloWorld", Target: "Olá mundo"},
+		{Key: "itemCount", Target: "{count, plural, =1{1 item} other{{count} itens}}"},
+	}, f.Entries)
+}
+
+func TestParseARBInvalid(t *testing.T) {
+	_, err := ParseARB(strings.NewReader(`["not", "a", "bundle"]`))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,133 @@
+// Package locale checks that the strings of localisation files are written in
+// the language the file declares
+//
+// It parses gettext PO, XLIFF 1.2 and 2.0, Android strings.xml, Apple .strings
+// and Flutter ARB files, and reports entries that were left in the source
+// language or pasted in the wrong one
+package locale
+
+import (
+	"github.com/rylans/getlang"
+	"golang.org/x/text/language"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Entry is a single translatable string
+type Entry struct {
+	// Key identifies the string: the msgid of a PO file, the id of an XLIFF
+	// unit or the name of an Android, Apple or ARB string
+	Key string
+	// Source is the string in the source language, when the format records it
+	Source string
+	// Target is the translated string
+	Target string
+}
+
+// File is a parsed localisation file
+type File struct {
+	// Locale is the language the file declares for its targets, or und
+	Locale language.Tag
+	// SourceLocale is the language of the source strings, or und
+	SourceLocale language.Tag
+	Entries      []Entry
+}
+
+// Mismatch is an entry whose target was detected in another language than the
+// file declares
+type Mismatch struct {
+	Entry Entry
+	Info  getlang.Info
+}
+
+// Validator finds entries whose language does not match their file's locale
+//
+// The zero value reports every mismatch, however short the string or low the
+// confidence
+type Validator struct {
+	Detector getlang.Detector
+	// MinConfidence is the confidence a detection needs before it is reported
+	MinConfidence float64
+	// MinChars skips strings with fewer scored characters than this
+	MinChars int
+	// Allow lists brand names and other terms that are the same in every
+	// language; they are removed from targets before detection
+	Allow []string
+}
+
+// placeholder matches printf verbs, ICU and Android arguments, and {{mustache}} tags
+var placeholder = regexp.MustCompile(`%(?:\d+\$)?[-+ #0]*\d*(?:\.\d+)?[a-zA-Z@%]|%\([^)]*\)[a-z]|\{\{[^}]*\}\}|\{[^{}]*\}`)
+
+// Validate detects the language of each target in f and returns the entries
+// that do not match f.Locale
+//
+// Files without a declared locale have no mismatches, and neither have files
+// in a locale getlang does not support, such as Swedish: their strings would
+// all be detected as some other language
+func (v Validator) Validate(f File) []Mismatch {
+	if f.Locale == language.Und || !getlang.Supports(f.Locale) {
+		return nil
+	}
+	declared, _ := f.Locale.Base()
+
+	var mismatches []Mismatch
+	for _, e := range f.Entries {
+		text := v.stripAllowed(placeholder.ReplaceAllString(e.Target, " "))
+		info, err := v.Detector.FromString(text)
+		if err != nil || info.LanguageCode() == "und" || info.ScoredChars() < v.MinChars || info.Confidence() < v.MinConfidence {
+			continue
+		}
+		if detected, _ := info.Tag().Base(); detected != declared {
+			mismatches = append(mismatches, Mismatch{Entry: e, Info: info})
+		}
+	}
+	return mismatches
+}
+
+func (v Validator) stripAllowed(text string) string {
+	for _, term := range v.Allow {
+		text = strings.Replace(text, term, " ", -1)
+	}
+	return text
+}
+
+var (
+	androidValues = regexp.MustCompile(`values-([a-z]{2,3})(?:-r([A-Z]{2}))?$`)
+	appleProject  = regexp.MustCompile(`^([A-Za-z_-]+)\.lproj$`)
+	fileSuffix    = regexp.MustCompile(`[_.-]([a-z]{2,3}(?:[_-][A-Za-z]{2,4})*)$`)
+)
+
+// LocaleFromPath guesses the locale of a file from its path, as in
+// res/values-fr-rCA/strings.xml, fr.lproj/Localizable.strings, app_fr.arb or
+// po/pt_BR.po; it returns und when the path names no locale
+func LocaleFromPath(path string) language.Tag {
+	dir := filepath.Base(filepath.Dir(path))
+	if m := androidValues.FindStringSubmatch(dir); m != nil {
+		return parseLocale(m[1] + "-" + m[2])
+	}
+	if m := appleProject.FindStringSubmatch(dir); m != nil {
+		return parseLocale(m[1])
+	}
+
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if m := fileSuffix.FindStringSubmatch(name); m != nil {
+		if tag := parseLocale(m[1]); tag != language.Und {
+			return tag
+		}
+	}
+	return parseLocale(name)
+}
+
+// parseLocale accepts BCP 47 tags as well as POSIX-style ones such as pt_BR
+func parseLocale(s string) language.Tag {
+	s = strings.Trim(strings.Replace(strings.TrimSpace(s), "_", "-", -1), "-")
+	if s == "" {
+		return language.Und
+	}
+	tag, err := language.Parse(s)
+	if err != nil {
+		return language.Und
+	}
+	return tag
+}
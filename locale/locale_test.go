@@ -0,0 +1,74 @@
+package locale
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+var frenchFile = File{
+	Locale: language.French,
+	Entries: []Entry{
+		{Key: "welcome", Target: "Bienvenue dans votre espace personnel, nous sommes heureux de vous revoir"},
+		{Key: "logout", Target: "You have been signed out of your account, please sign in again"},
+		{Key: "greeting", Target: "Bonjour %1$s, vous avez %2$d nouveaux messages dans votre boîte de réception"},
+		{Key: "brand", Target: "Acme Cloud Sync Pro"},
+		{Key: "ok", Target: "OK"},
+	},
+}
+
+func TestValidate(t *testing.T) {
+	mismatches := Validator{MinChars: 10, MinConfidence: 0.5}.Validate(frenchFile)
+
+	assert.Equal(t, 1, len(mismatches))
+	assert.Equal(t, "logout", mismatches[0].Entry.Key)
+	assert.Equal(t, "en", mismatches[0].Info.LanguageCode())
+}
+
+func TestValidateAllow(t *testing.T) {
+	f := File{Locale: language.German, Entries: []Entry{
+		{Key: "sync", Target: "Acme Cloud Sync Pro synchronisiert Ihre Dateien automatisch mit allen Geräten"},
+	}}
+
+	assert.Equal(t, 0, len(Validator{MinChars: 10, Allow: []string{"Acme Cloud Sync Pro"}}.Validate(f)))
+}
+
+func TestValidateWithoutLocale(t *testing.T) {
+	f := frenchFile
+	f.Locale = language.Und
+
+	assert.Nil(t, Validator{}.Validate(f))
+}
+
+func TestValidateUnsupportedLocale(t *testing.T) {
+	swedish := File{Locale: language.Swedish, Entries: []Entry{
+		{Key: "welcome", Target: "Alla människor är födda fria och lika i värde och rättigheter"},
+	}}
+	persian := File{Locale: language.Persian, Entries: []Entry{
+		{Key: "welcome", Target: "تمام افراد بشر آزاد به دنیا می‌آیند و از لحاظ حیثیت و حقوق با هم برابرند"},
+	}}
+
+	assert.Nil(t, Validator{MinConfidence: 0.5}.Validate(swedish))
+	assert.Nil(t, Validator{MinConfidence: 0.5}.Validate(persian))
+}
+
+func TestPlaceholders(t *testing.T) {
+	assert.Equal(t, "Hallo  , du hast   neue  ", placeholder.ReplaceAllString("Hallo %1$s, du hast %d neue {count}", " "))
+	assert.Equal(t, "  und  ", placeholder.ReplaceAllString("{{name}} und %(item)s", " "))
+}
+
+func TestLocaleFromPath(t *testing.T) {
+	for path, expected := range map[string]string{
+		"app/src/main/res/values-fr-rCA/strings.xml": "fr-CA",
+		"app/src/main/res/values-de/strings.xml":     "de",
+		"app/src/main/res/values/strings.xml":        "und",
+		"Resources/pt-BR.lproj/Localizable.strings":  "pt-BR",
+		"lib/l10n/app_es.arb":                        "es",
+		"lib/l10n/intl_zh_Hant.arb":                  "zh-Hant",
+		"po/pt_BR.po":                                "pt-BR",
+		"locales/ja.xliff":                           "ja",
+		"README.md":                                  "und",
+	} {
+		assert.Equal(t, expected, LocaleFromPath(path).String(), path)
+	}
+}
@@ -0,0 +1,102 @@
+package locale
+
+import (
+	"bufio"
+	"fmt"
+	"golang.org/x/text/language"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ParsePO parses a gettext PO file
+//
+// The locale comes from the Language field of the header entry. Every msgstr of
+// a plural entry becomes its own Entry; untranslated and obsolete entries are
+// skipped
+func ParsePO(reader io.Reader) (File, error) {
+	f := File{Locale: language.Und, SourceLocale: language.Und}
+	scanner := bufio.NewScanner(reader)
+
+	var msgid, keyword string
+	var msgstrs []string
+	var lineNo int
+	flush := func() {
+		for _, s := range msgstrs {
+			switch {
+			case msgid == "":
+				f.Locale = poLanguage(s)
+			case s != "":
+				f.Entries = append(f.Entries, Entry{Key: msgid, Source: msgid, Target: s})
+			}
+		}
+		msgid, msgstrs = "", nil
+	}
+
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			if line == "" && len(msgstrs) > 0 {
+				flush()
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, `"`) {
+			s, err := strconv.Unquote(line)
+			if err != nil {
+				return f, fmt.Errorf("locale: line %d: invalid string %s", lineNo, line)
+			}
+			appendPO(keyword, s, &msgid, msgstrs)
+			continue
+		}
+
+		fields := strings.SplitN(line, " ", 2)
+		if len(fields) != 2 {
+			return f, fmt.Errorf("locale: line %d: unexpected %q", lineNo, line)
+		}
+		s, err := strconv.Unquote(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return f, fmt.Errorf("locale: line %d: invalid string %s", lineNo, fields[1])
+		}
+
+		keyword = fields[0]
+		switch {
+		case keyword == "msgctxt" || keyword == "msgid":
+			if len(msgstrs) > 0 {
+				flush()
+			}
+			if keyword == "msgid" {
+				msgid = s
+			}
+		case keyword == "msgid_plural":
+		case strings.HasPrefix(keyword, "msgstr"):
+			msgstrs = append(msgstrs, s)
+		default:
+			return f, fmt.Errorf("locale: line %d: unknown keyword %s", lineNo, keyword)
+		}
+	}
+	flush()
+	return f, scanner.Err()
+}
+
+// appendPO continues the string of the last keyword onto the next line
+func appendPO(keyword, s string, msgid *string, msgstrs []string) {
+	switch {
+	case keyword == "msgid":
+		*msgid += s
+	case strings.HasPrefix(keyword, "msgstr") && len(msgstrs) > 0:
+		msgstrs[len(msgstrs)-1] += s
+	}
+}
+
+// poLanguage reads the Language field of a PO header
+func poLanguage(header string) language.Tag {
+	for _, field := range strings.Split(header, "\n") {
+		if strings.HasPrefix(field, "Language:") {
+			return parseLocale(strings.TrimPrefix(field, "Language:"))
+		}
+	}
+	return language.Und
+}
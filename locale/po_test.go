@@ -0,0 +1,54 @@
+package locale
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const poFile = `# Spanish translations
+msgid ""
+msgstr ""
+"Project-Id-Version: app 1.0\n"
+"Language: es_MX\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+
+#: src/main.c:10
+msgid "Welcome back"
+msgstr "Bienvenido de nuevo"
+
+#, c-format
+msgctxt "inbox"
+msgid "You have %d new message"
+msgid_plural "You have %d new messages"
+msgstr[0] "Tienes %d mensaje nuevo"
+msgstr[1] ""
+"Tienes %d mensajes "
+"nuevos"
+
+msgid "Untranslated"
+msgstr ""
+
+#~ msgid "Obsolete"
+#~ msgstr "Obsoleto"
+`
+
+func TestParsePO(t *testing.T) {
+	f, err := ParsePO(strings.NewReader(poFile))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "es-MX", f.Locale.String())
+	assert.Equal(t, []Entry{
+		{Key: "Welcome back", Source: "Welcome back", Target: "Bienvenido de nuevo"},
+		{Key: "You have %d new message", Source: "You have %d new message", Target: "Tienes %d mensaje nuevo"},
+		{Key: "You have %d new message", Source: "You have %d new message", Target: "Tienes %d mensajes nuevos"},
+	}, f.Entries)
+}
+
+func TestParsePOInvalid(t *testing.T) {
+	_, err := ParsePO(strings.NewReader("msgid \"unterminated\nmsgstr \"\"\n"))
+	assert.NotNil(t, err)
+
+	_, err = ParsePO(strings.NewReader("msgfoo \"x\"\n"))
+	assert.NotNil(t, err)
+}
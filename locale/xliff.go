@@ -0,0 +1,103 @@
+package locale
+
+import (
+	"encoding/xml"
+	"golang.org/x/text/language"
+	"io"
+	"strings"
+)
+
+// ParseXLIFF parses an XLIFF 1.2 or 2.0 file
+//
+// The locales come from the target-language and source-language attributes of
+// the first <file> in XLIFF 1.2, or from trgLang and srcLang in XLIFF 2.0. Text
+// inside inline elements such as <g> and <pc> is kept; units without a target
+// are skipped. Suggestions in <alt-trans>, the segmented copy in <seg-source>
+// and XLIFF 2.0 <ignorable> are not part of the unit's text
+func ParseXLIFF(reader io.Reader) (File, error) {
+	f := File{Locale: language.Und, SourceLocale: language.Und}
+	dec := xml.NewDecoder(reader)
+
+	var id string
+	var source, target strings.Builder
+	var current *strings.Builder
+	var depth, textDepth, skipDepth int
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return f, nil
+		}
+		if err != nil {
+			return f, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			if skipDepth > 0 {
+				continue
+			}
+			switch t.Name.Local {
+			case "xliff", "file":
+				readXLIFFLocales(t.Attr, &f)
+			case "trans-unit", "unit":
+				id = attr(t.Attr, "id")
+				source.Reset()
+				target.Reset()
+			case "source":
+				current, textDepth = startSegment(&source), depth
+			case "target":
+				current, textDepth = startSegment(&target), depth
+			case "alt-trans", "seg-source", "ignorable":
+				skipDepth = depth
+			}
+		case xml.EndElement:
+			if depth == skipDepth {
+				skipDepth = 0
+			}
+			if depth == textDepth {
+				current, textDepth = nil, 0
+			}
+			depth--
+			if (t.Name.Local == "trans-unit" || t.Name.Local == "unit") && target.Len() > 0 {
+				f.Entries = append(f.Entries, Entry{Key: id, Source: source.String(), Target: target.String()})
+			}
+		case xml.CharData:
+			if current != nil {
+				current.Write(t)
+			}
+		}
+	}
+}
+
+// startSegment separates the segments of an XLIFF 2.0 unit
+func startSegment(sb *strings.Builder) *strings.Builder {
+	if sb.Len() > 0 {
+		sb.WriteByte(' ')
+	}
+	return sb
+}
+
+func readXLIFFLocales(attrs []xml.Attr, f *File) {
+	for _, a := range attrs {
+		switch a.Name.Local {
+		case "target-language", "trgLang":
+			if f.Locale == language.Und {
+				f.Locale = parseLocale(a.Value)
+			}
+		case "source-language", "srcLang":
+			if f.SourceLocale == language.Und {
+				f.SourceLocale = parseLocale(a.Value)
+			}
+		}
+	}
+}
+
+func attr(attrs []xml.Attr, name string) string {
+	for _, a := range attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,95 @@
+package locale
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const xliff12 = `<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+  <file source-language="en" target-language="de-DE" datatype="plaintext" original="app">
+    <body>
+      <trans-unit id="save">
+        <source>Save your changes</source>
+        <target>Speichern Sie Ihre <g id="1">Änderungen</g></target>
+      </trans-unit>
+      <trans-unit id="new">
+        <source>Not translated yet</source>
+      </trans-unit>
+    </body>
+  </file>
+</xliff>`
+
+const xliff20 = `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en" trgLang="it">
+  <file id="f1">
+    <unit id="u1">
+      <segment><source>Hello.</source><target>Ciao.</target></segment>
+      <segment><source>See you <pc id="1">soon</pc>.</source><target>A <pc id="1">presto</pc>.</target></segment>
+    </unit>
+  </file>
+</xliff>`
+
+func TestParseXLIFF12(t *testing.T) {
+	f, err := ParseXLIFF(strings.NewReader(xliff12))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "de-DE", f.Locale.String())
+	assert.Equal(t, "en", f.SourceLocale.String())
+	assert.Equal(t, []Entry{{Key: "save", Source: "Save your changes", Target: "Speichern Sie Ihre Änderungen"}}, f.Entries)
+}
+
+func TestParseXLIFF20(t *testing.T) {
+	f, err := ParseXLIFF(strings.NewReader(xliff20))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "it", f.Locale.String())
+	assert.Equal(t, "en", f.SourceLocale.String())
+	assert.Equal(t, []Entry{{Key: "u1", Source: "Hello. See you soon.", Target: "Ciao. A presto."}}, f.Entries)
+}
+
+const xliffAlternatives = `<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+  <file source-language="en" target-language="fr" datatype="plaintext" original="app">
+    <body>
+      <trans-unit id="hello">
+        <source>Hello everyone</source>
+        <seg-source><mrk mtype="seg" mid="1">Hello everyone</mrk></seg-source>
+        <target>Bonjour</target>
+        <alt-trans match-quality="80">
+          <source>Hello world</source>
+          <target>Salut tout le monde</target>
+        </alt-trans>
+      </trans-unit>
+    </body>
+  </file>
+</xliff>`
+
+const xliff20Ignorable = `<?xml version="1.0" encoding="UTF-8"?>
+<xliff xmlns="urn:oasis:names:tc:xliff:document:2.0" version="2.0" srcLang="en" trgLang="it">
+  <file id="f1">
+    <unit id="u1">
+      <segment><source>Hello.</source><target>Ciao.</target></segment>
+      <ignorable><source>(draft)</source><target>(bozza)</target></ignorable>
+      <segment><source>Bye.</source><target>Addio.</target></segment>
+    </unit>
+  </file>
+</xliff>`
+
+func TestParseXLIFFSkipsAlternatives(t *testing.T) {
+	f, err := ParseXLIFF(strings.NewReader(xliffAlternatives))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Entry{{Key: "hello", Source: "Hello everyone", Target: "Bonjour"}}, f.Entries)
+
+	f, err = ParseXLIFF(strings.NewReader(xliff20Ignorable))
+
+	assert.Nil(t, err)
+	assert.Equal(t, []Entry{{Key: "u1", Source: "Hello. Bye.", Target: "Ciao. Addio."}}, f.Entries)
+}
+
+func TestParseXLIFFInvalid(t *testing.T) {
+	_, err := ParseXLIFF(strings.NewReader("<xliff><file>"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,402 @@
+//go:build ignore
+// +build ignore
+
+// makeprofiles builds the unigram, bigram, quadgram and 5-gram profiles in
+// corpusprofiles.go from gettext catalogs, and writes text from held-out
+// catalogs to testdata/heldout.txt
+//
+// Usage:
+//
+//	go run makeprofiles.go [-locales /usr/share/locale]
+//
+// The catalogs hold the translated messages of free software, so the profiles
+// follow the text of user interfaces. The English profiles are taken from the
+// untranslated messages. Every fifth catalog, picked by a hash of its file name,
+// is held out for testing
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"go/format"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+	"hash/fnv"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// locales maps each language key to the catalog directories written in it
+var locales = map[string][]string{
+	"ca": {"ca"}, "cs": {"cs"}, "de": {"de"}, "es": {"es"}, "fr": {"fr"}, "gl": {"gl"},
+	"hi": {"hi"}, "hr": {"hr"}, "hu": {"hu"}, "id": {"id"}, "it": {"it"}, "ms": {"ms"},
+	"nl": {"nl"}, "pl": {"pl"}, "pt": {"pt", "pt_BR", "pt_PT"}, "ro": {"ro"}, "ru": {"ru"},
+	"sk": {"sk"}, "sl": {"sl"}, "sr-Cyrl": {"sr"}, "sr-Latn": {"sr@latin", "sr@Latn"},
+	"tl": {"tl", "fil"}, "tr": {"tr"}, "uk": {"uk"}, "vi": {"vi"},
+	"ja": {"ja"}, "zh": {"zh_CN", "zh_TW", "zh_HK", "zh_Hans", "zh_Hant"},
+}
+
+// englishSources are the catalogs whose untranslated messages make up the
+// English text
+var englishSources = []string{"fr", "de", "es"}
+
+// hanLanguages only get a unigram profile, of their Han characters
+var hanLanguages = map[string]bool{"ja": true, "zh": true}
+
+// minCorpus is the fewest characters of training text a language needs for
+// its profiles; smaller catalogs hold little more than country names
+const minCorpus = 40000
+
+var profileSizes = map[int]int{1: 40, 2: 150, 4: 256, 5: 256}
+
+const hanProfileSize = 600
+
+const heldOutSamples = 12
+
+var (
+	directive = regexp.MustCompile(`%(\d+\$)?[-+ #0-9.*']*(hh|h|ll|l|L|q|j|z|t)?[a-zA-Z%]|` +
+		`\{[^}]*\}|<[^>]*>|\b\w+://\S+|\S+@\S+|(^|\s)--?[A-Za-z][\w-]*|\$\{?\w+\}?|\\[nt]`)
+	technical = regexp.MustCompile(`[0-9/\\=|]|\w\.\w`)
+)
+
+func main() {
+	dir := flag.String("locales", "/usr/share/locale", "directory of gettext catalogs")
+	flag.Parse()
+
+	train := make(map[string][]string)
+	test := make(map[string][]string)
+	for k, dirs := range locales {
+		train[k], test[k] = messages(*dir, dirs, false)
+	}
+	train["en"], test["en"] = messages(*dir, englishSources, true)
+
+	profiles := make(map[int]map[string][]string)
+	for n := range profileSizes {
+		profiles[n] = make(map[string][]string)
+	}
+	for k, texts := range train {
+		log.Printf("%s: %d training and %d held-out characters", k, chars(texts), chars(test[k]))
+		if chars(texts) < minCorpus {
+			log.Printf("%s: %d characters, skipped", k, chars(texts))
+			continue
+		}
+		if hanLanguages[k] {
+			profiles[1][k] = hanProfile(texts)
+			continue
+		}
+		for n, size := range profileSizes {
+			profiles[n][k] = profile(texts, n, size)
+		}
+	}
+
+	src, err := format.Source(source(profiles))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("corpusprofiles.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join("testdata", "heldout.txt"), heldOut(test, profiles), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// messages returns the cleaned messages of the catalogs in dirs, split into
+// training and held-out text; english takes the untranslated messages instead
+func messages(root string, dirs []string, english bool) (train, test []string) {
+	seen := make(map[string]bool)
+	for _, d := range dirs {
+		paths, _ := filepath.Glob(filepath.Join(root, d, "LC_MESSAGES", "*.mo"))
+		sort.Strings(paths)
+		for _, path := range paths {
+			catalog, err := readCatalog(path)
+			if err != nil {
+				log.Printf("%s: %v", path, err)
+				continue
+			}
+			for _, m := range catalog {
+				text := m.str
+				if english {
+					text = m.id
+				}
+				if m.id == "" || m.id == m.str || seen[text] {
+					continue
+				}
+				seen[text] = true
+				for _, part := range strings.Split(text, "\x00") {
+					part = cleanMessage(part)
+					if utf8.RuneCountInString(part) < 2 {
+						continue
+					}
+					if isHeldOut(path) {
+						test = append(test, part)
+					} else {
+						train = append(train, part)
+					}
+				}
+			}
+		}
+	}
+	return train, test
+}
+
+type message struct {
+	id, str string
+}
+
+// readCatalog reads the messages of a compiled gettext catalog
+func readCatalog(path string) ([]message, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < 20 {
+		return nil, fmt.Errorf("too short")
+	}
+	var order binary.ByteOrder
+	switch binary.LittleEndian.Uint32(b) {
+	case 0x950412de:
+		order = binary.LittleEndian
+	case 0xde120495:
+		order = binary.BigEndian
+	default:
+		return nil, fmt.Errorf("not a catalog")
+	}
+	count := int(order.Uint32(b[8:]))
+	ids, strs := int(order.Uint32(b[12:])), int(order.Uint32(b[16:]))
+
+	str := func(table, i int) (string, error) {
+		at := table + 8*i
+		if at+8 > len(b) {
+			return "", fmt.Errorf("truncated")
+		}
+		length, offset := int(order.Uint32(b[at:])), int(order.Uint32(b[at+4:]))
+		if offset+length > len(b) {
+			return "", fmt.Errorf("truncated")
+		}
+		return string(b[offset : offset+length]), nil
+	}
+
+	var catalog []message
+	for i := 0; i < count; i++ {
+		id, err := str(ids, i)
+		if err != nil {
+			return nil, err
+		}
+		s, err := str(strs, i)
+		if err != nil {
+			return nil, err
+		}
+		if id == "" {
+			if !strings.Contains(strings.ToLower(s), "charset=utf-8") {
+				return nil, fmt.Errorf("not UTF-8")
+			}
+			continue
+		}
+		if j := strings.IndexByte(id, '\x04'); j >= 0 {
+			id = id[j+1:]
+		}
+		catalog = append(catalog, message{id: id, str: s})
+	}
+	return catalog, nil
+}
+
+// cleanMessage drops format directives, markup, URLs, command line options,
+// accelerator markers and words holding digits or paths
+func cleanMessage(s string) string {
+	if !utf8.ValidString(s) {
+		return ""
+	}
+	s = directive.ReplaceAllString(s, " ")
+	s = strings.NewReplacer("_", "", "&", "").Replace(s)
+
+	var words []string
+	for _, w := range strings.Fields(s) {
+		if !technical.MatchString(w) {
+			words = append(words, w)
+		}
+	}
+	return norm.NFC.String(width.Fold.String(strings.Join(words, " ")))
+}
+
+func isHeldOut(path string) bool {
+	h := fnv.New32a()
+	h.Write([]byte(filepath.Base(path)))
+	return h.Sum32()%5 == 0
+}
+
+func chars(texts []string) int {
+	var n int
+	for _, t := range texts {
+		n += utf8.RuneCountInString(t)
+	}
+	return n
+}
+
+// profile returns the size most frequent n-grams of texts, counted the way
+// the detector counts them
+func profile(texts []string, n, size int) []string {
+	counts := make(map[string]int)
+	for _, t := range texts {
+		txt := []rune{' '}
+		for _, r := range t {
+			if unicode.IsPunct(r) || unicode.IsSpace(r) {
+				r = ' '
+			}
+			txt = append(txt, unicode.ToLower(r))
+		}
+		txt = append(txt, ' ')
+
+		for i := 0; i+n <= len(txt); i++ {
+			window := txt[i : i+n]
+			if n == 1 && window[0] == ' ' || strings.Contains(string(window), "  ") || !isWord(window) {
+				continue
+			}
+			counts[string(window)]++
+		}
+	}
+	return top(counts, size)
+}
+
+// isWord reports whether an n-gram holds only letters, marks and spaces
+func isWord(window []rune) bool {
+	for _, r := range window {
+		if r != ' ' && !unicode.IsLetter(r) && !unicode.IsMark(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// hanProfile returns the most frequent Han characters of texts
+func hanProfile(texts []string) []string {
+	counts := make(map[string]int)
+	for _, t := range texts {
+		for _, r := range t {
+			if unicode.Is(unicode.Han, r) {
+				counts[string(r)]++
+			}
+		}
+	}
+	return top(counts, hanProfileSize)
+}
+
+func top(counts map[string]int, size int) []string {
+	grams := make([]string, 0, len(counts))
+	for g := range counts {
+		grams = append(grams, g)
+	}
+	sort.Slice(grams, func(i, j int) bool {
+		if counts[grams[i]] == counts[grams[j]] {
+			return grams[i] < grams[j]
+		}
+		return counts[grams[i]] > counts[grams[j]]
+	})
+	if len(grams) > size {
+		grams = grams[:size]
+	}
+	return grams
+}
+
+func source(profiles map[int]map[string][]string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by makeprofiles.go; DO NOT EDIT.\n\npackage getlang\n\n")
+	buf.WriteString("// corpusProfiles holds the n-gram profiles built from gettext catalogs,\n")
+	buf.WriteString("// indexed by order and language, most frequent first\n")
+	buf.WriteString("var corpusProfiles = map[int]map[string][]string{\n")
+	for _, n := range sortedOrders(profiles) {
+		fmt.Fprintf(&buf, "\t%d: {\n", n)
+		for _, k := range sortedKeys(profiles[n]) {
+			fmt.Fprintf(&buf, "\t\t%q: {", k)
+			for i, g := range profiles[n][k] {
+				if i > 0 {
+					buf.WriteString(", ")
+				}
+				fmt.Fprintf(&buf, "%q", g)
+			}
+			buf.WriteString("},\n")
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// heldOut picks sentences of held-out text for each language with profiles;
+// Han languages also get runs of Han characters, marked with a "-Hani" suffix
+func heldOut(test map[string][]string, profiles map[int]map[string][]string) []byte {
+	var buf bytes.Buffer
+	for _, k := range sortedKeys(profiles[1]) {
+		var sentences, runs []string
+		for _, t := range test[k] {
+			if n := utf8.RuneCountInString(t); n >= 60 && n <= 200 {
+				sentences = append(sentences, t)
+			}
+			if hanLanguages[k] {
+				for _, run := range hanRuns(t) {
+					if utf8.RuneCountInString(run) >= 2 {
+						runs = append(runs, run)
+					}
+				}
+			}
+		}
+		for _, t := range spread(sentences, heldOutSamples) {
+			fmt.Fprintf(&buf, "%s\t%s\n", k, t)
+		}
+		for _, t := range spread(runs, 4*heldOutSamples) {
+			fmt.Fprintf(&buf, "%s-Hani\t%s\n", k, t)
+		}
+	}
+	return buf.Bytes()
+}
+
+// spread picks n texts evenly spaced through texts, so that they come from
+// more than one catalog
+func spread(texts []string, n int) []string {
+	if len(texts) <= n {
+		return texts
+	}
+	picked := make([]string, n)
+	for i := range picked {
+		picked[i] = texts[i*len(texts)/n]
+	}
+	return picked
+}
+
+func hanRuns(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.Is(unicode.Han, r)
+	})
+}
+
+func sortedOrders(m map[int]map[string][]string) []int {
+	var orders []int
+	for n := range m {
+		orders = append(orders, n)
+	}
+	sort.Ints(orders)
+	return orders
+}
+
+func sortedKeys(m map[string][]string) []string {
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func init() {
+	log.SetFlags(0)
+	log.SetOutput(os.Stderr)
+}
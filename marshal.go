@@ -0,0 +1,133 @@
+package getlang
+
+import (
+	"encoding/json"
+	"fmt"
+	"golang.org/x/text/language"
+)
+
+// InfoSchemaVersion is the version of the JSON form of Info; it changes only
+// when a field is removed or changes meaning
+const InfoSchemaVersion = 1
+
+// infoJSON is the JSON form of Info
+//
+// name and selfName are written for readers of the JSON and ignored when it is
+// decoded; they follow from the tag
+type infoJSON struct {
+	Version          int             `json:"version"`
+	Code             string          `json:"code"`
+	Tag              string          `json:"tag"`
+	Name             string          `json:"name"`
+	SelfName         string          `json:"selfName"`
+	Confidence       float64         `json:"confidence"`
+	RegionConfidence float64         `json:"regionConfidence,omitempty"`
+	Charset          string          `json:"charset,omitempty"`
+	Extractor        string          `json:"extractor,omitempty"`
+	InvalidCount     int             `json:"invalidCount,omitempty"`
+	ScoredChars      int             `json:"scoredChars,omitempty"`
+	Candidates       []candidateJSON `json:"candidates,omitempty"`
+}
+
+type candidateJSON struct {
+	Code       string  `json:"code"`
+	Tag        string  `json:"tag"`
+	Confidence float64 `json:"confidence"`
+}
+
+// String returns the BCP 47 tag and the confidence, such as "en-GB 0.9312"
+func (info Info) String() string {
+	return fmt.Sprintf("%s %.4f", info.BCP47(), info.probability)
+}
+
+// MarshalText returns the BCP 47 tag of the detected language
+func (info Info) MarshalText() ([]byte, error) {
+	return []byte(info.BCP47()), nil
+}
+
+// UnmarshalText sets the language from a BCP 47 tag, leaving the confidence
+// and everything else at zero
+func (info *Info) UnmarshalText(text []byte) error {
+	lang, tag, err := parseInfoTag(string(text))
+	if err != nil {
+		return err
+	}
+	*info = Info{lang: lang, langTag: tag}
+	return nil
+}
+
+// MarshalJSON writes info as an object of version InfoSchemaVersion
+func (info Info) MarshalJSON() ([]byte, error) {
+	v := infoJSON{
+		Version:          InfoSchemaVersion,
+		Code:             info.LanguageCode(),
+		Tag:              info.BCP47(),
+		Name:             info.LanguageName(),
+		SelfName:         info.SelfName(),
+		Confidence:       info.probability,
+		RegionConfidence: info.regionProbability,
+		Charset:          info.charset,
+		Extractor:        info.extractor,
+		InvalidCount:     info.invalid,
+		ScoredChars:      info.scored,
+	}
+	for _, c := range info.Candidates() {
+		v.Candidates = append(v.Candidates, candidateJSON{Code: c.LanguageCode(), Tag: c.BCP47(), Confidence: c.probability})
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON reads an object written by MarshalJSON
+//
+// It fails on schema versions newer than InfoSchemaVersion
+func (info *Info) UnmarshalJSON(data []byte) error {
+	var v infoJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.Version < 1 || v.Version > InfoSchemaVersion {
+		return fmt.Errorf("getlang: unsupported Info schema version %d", v.Version)
+	}
+
+	lang, tag, err := parseInfoTag(v.Tag)
+	if err != nil {
+		return err
+	}
+	result := Info{
+		lang:              lang,
+		probability:       v.Confidence,
+		langTag:           tag,
+		charset:           v.Charset,
+		invalid:           v.InvalidCount,
+		scored:            v.ScoredChars,
+		extractor:         v.Extractor,
+		regionProbability: v.RegionConfidence,
+	}
+	if len(v.Candidates) > maxCandidates {
+		return fmt.Errorf("getlang: more than %d candidates", maxCandidates)
+	}
+	for i, c := range v.Candidates {
+		lang, _, err := parseInfoTag(c.Tag)
+		if err != nil {
+			return err
+		}
+		result.candidates[i] = candidate{lang, c.Confidence}
+	}
+	*info = result
+	return nil
+}
+
+// parseInfoTag turns the output of BCP47 back into a language key and tag
+func parseInfoTag(s string) (string, language.Tag, error) {
+	raw, err := language.Raw.Parse(s)
+	if err != nil {
+		return "", language.Und, err
+	}
+	base, script, _ := raw.Raw()
+	lang := base.String()
+	if script.String() != "Zzzz" {
+		lang += "-" + script.String()
+	}
+	tag, err := language.Parse(s)
+	return lang, tag, err
+}
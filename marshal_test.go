@@ -0,0 +1,206 @@
+package getlang
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"strings"
+	"testing"
+)
+
+func TestInfoJSONRoundTrip(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("Você pegou o ônibus ou o trem para chegar ao trabalho hoje de manhã?")
+
+	b, err := json.Marshal(info)
+	assert.Nil(t, err)
+
+	var decoded Info
+	assert.Nil(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, info, decoded)
+}
+
+func TestInfoJSONRoundTripRomanized(t *testing.T) {
+	info := FromString("kya haal hai bhai, aaj kal kya chal raha hai")
+
+	b, err := json.Marshal(info)
+	assert.Nil(t, err)
+
+	var decoded Info
+	assert.Nil(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, info, decoded)
+	assert.Equal(t, "hi-Latn", decoded.BCP47())
+}
+
+func TestInfoJSONRoundTripCharset(t *testing.T) {
+	info := FromBytes([]byte{0xD3, 0xD4, 0xC1, 0xD4, 0xC5, 0xCA, 0x20, 0xCE, 0xC1, 0x20, 0xD2, 0xD5, 0xD3, 0xD3, 0xCB, 0xCF, 0xCD})
+
+	b, err := json.Marshal(info)
+	assert.Nil(t, err)
+
+	var decoded Info
+	assert.Nil(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, info, decoded)
+	assert.Equal(t, "KOI8-R", decoded.Charset())
+}
+
+func TestInfoJSONSchema(t *testing.T) {
+	info := FromString("Ich möchte eine Tasse Kaffee mit Milch und Zucker, bitte")
+
+	b, err := json.Marshal(info)
+	assert.Nil(t, err)
+
+	var v map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &v))
+	assert.Equal(t, 1.0, v["version"])
+	assert.Equal(t, "de", v["code"])
+	assert.Equal(t, "de", v["tag"])
+	assert.Equal(t, "German", v["name"])
+	assert.Equal(t, "Deutsch", v["selfName"])
+	assert.Equal(t, info.Confidence(), v["confidence"])
+	assert.NotContains(t, v, "charset")
+
+	candidates := v["candidates"].([]interface{})
+	assert.Equal(t, map[string]interface{}{"code": "de", "tag": "de", "confidence": info.Confidence()}, candidates[0])
+}
+
+func TestInfoJSONUnsupportedVersion(t *testing.T) {
+	var info Info
+
+	err := json.Unmarshal([]byte(`{"version":2,"code":"en","tag":"en","confidence":1}`), &info)
+	assert.EqualError(t, err, "getlang: unsupported Info schema version 2")
+
+	err = json.Unmarshal([]byte(`{"code":"en","tag":"en","confidence":1}`), &info)
+	assert.EqualError(t, err, "getlang: unsupported Info schema version 0")
+}
+
+func TestInfoJSONInvalidTag(t *testing.T) {
+	var info Info
+
+	err := json.Unmarshal([]byte(`{"version":1,"code":"en","tag":"not a tag","confidence":1}`), &info)
+	assert.NotNil(t, err)
+}
+
+func TestInfoTextRoundTrip(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("My favourite colour is grey, and the theatre is in the centre of town")
+
+	b, err := info.MarshalText()
+	assert.Nil(t, err)
+	assert.Equal(t, "en-GB", string(b))
+
+	var decoded Info
+	assert.Nil(t, decoded.UnmarshalText(b))
+	assert.Equal(t, "en", decoded.LanguageCode())
+	assert.Equal(t, info.Tag(), decoded.Tag())
+	assert.Equal(t, 0.0, decoded.Confidence())
+}
+
+func TestInfoAsJSONMapKey(t *testing.T) {
+	info := FromString("Ich möchte eine Tasse Kaffee mit Milch und Zucker, bitte")
+	b, err := json.Marshal(map[Info]int{info: 3})
+
+	assert.Nil(t, err)
+	assert.Equal(t, `{"de":3}`, string(b))
+}
+
+func TestInfoString(t *testing.T) {
+	info := Info{lang: "pt", probability: 0.75, langTag: language.MustParse("pt-BR")}
+
+	assert.Equal(t, "pt-BR 0.7500", info.String())
+}
+
+func TestCandidates(t *testing.T) {
+	info := FromString("a casa")
+	candidates := info.Candidates()
+
+	assert.True(t, len(candidates) > 1)
+	assert.True(t, len(candidates) <= maxCandidates)
+	assert.Equal(t, info.LanguageCode(), candidates[0].LanguageCode())
+	assert.Equal(t, info.Confidence(), candidates[0].Confidence())
+
+	var total float64
+	for i, c := range candidates {
+		total += c.Confidence()
+		assert.True(t, c.Confidence() >= minCandidateProbability)
+		if i > 0 {
+			assert.True(t, c.Confidence() <= candidates[i-1].Confidence())
+		}
+	}
+	assert.True(t, total <= 1+1e-9)
+}
+
+func TestCandidatesOfConfidentResult(t *testing.T) {
+	info := FromString(strings.Repeat("Ich möchte eine Tasse Kaffee mit Milch und Zucker, bitte. ", 20))
+
+	assert.Equal(t, 1, len(info.Candidates()))
+}
+
+func TestSubtitlesJSONKeepsCues(t *testing.T) {
+	subs, err := FromSRT(strings.NewReader(srtTrack))
+	assert.Nil(t, err)
+
+	b, err := json.Marshal(subs)
+	assert.Nil(t, err)
+
+	var v map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &v))
+	assert.Contains(t, v, "info")
+	cue := v["cues"].([]interface{})[0].(map[string]interface{})
+	for _, key := range []string{"index", "id", "start", "end", "text", "info"} {
+		assert.Contains(t, cue, key)
+	}
+
+	var decoded Subtitles
+	assert.Nil(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, subs, decoded)
+}
+
+func TestMessageJSONKeepsParts(t *testing.T) {
+	msg, err := FromMessage(strings.NewReader(multipartMessage))
+	assert.Nil(t, err)
+
+	b, err := json.Marshal(msg)
+	assert.Nil(t, err)
+
+	var v map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &v))
+	assert.Equal(t, msg.Subject, v["subject"])
+	assert.Contains(t, v, "info")
+	part := v["parts"].([]interface{})[0].(map[string]interface{})
+	for _, key := range []string{"contentType", "text", "info"} {
+		assert.Contains(t, part, key)
+	}
+
+	var decoded Message
+	assert.Nil(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, msg, decoded)
+}
+
+func TestInfoJSONWithoutCandidates(t *testing.T) {
+	info := FromString("a casa").WithoutCandidates()
+
+	b, err := json.Marshal(info)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(b), "candidates")
+	assert.Equal(t, 0, len(info.Candidates()))
+	assert.Equal(t, FromString("a casa").LanguageCode(), info.LanguageCode())
+}
+
+func TestHTMLInfoJSONKeepsDeclarations(t *testing.T) {
+	info, err := FromHTML(strings.NewReader(germanPage))
+	assert.Nil(t, err)
+
+	b, err := json.Marshal(info)
+	assert.Nil(t, err)
+
+	var v map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &v))
+	assert.Equal(t, "de-AT", v["langAttr"])
+	assert.Equal(t, "de", v["contentLanguage"])
+	assert.Equal(t, "de-AT", v["declared"])
+	assert.Equal(t, "de", v["info"].(map[string]interface{})["code"])
+
+	var decoded HTMLInfo
+	assert.Nil(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, info, decoded)
+	assert.Equal(t, "de-AT", decoded.Declared().String())
+}
@@ -0,0 +1,262 @@
+package getlang
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"golang.org/x/text/encoding/htmlindex"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// MessagePart is a text part of an email and the language of its new content
+type MessagePart struct {
+	// ContentType is the media type of the part, such as text/plain or text/html
+	ContentType string `json:"contentType"`
+	// Text is the new content of the part, without quoted replies and signatures
+	Text string `json:"text"`
+	Info Info   `json:"info"`
+}
+
+// Message is the language detection result for an email
+//
+// The embedded Info is the language of the new content of the whole message
+type Message struct {
+	Info
+	Subject string
+	Parts   []MessagePart
+}
+
+// messageJSON is the JSON form of Message; the MarshalJSON of the embedded
+// Info would otherwise leave out the subject and parts
+type messageJSON struct {
+	Info    Info          `json:"info"`
+	Subject string        `json:"subject,omitempty"`
+	Parts   []MessagePart `json:"parts"`
+}
+
+// MarshalJSON writes the Info of the whole message next to its subject and parts
+func (m Message) MarshalJSON() ([]byte, error) {
+	return json.Marshal(messageJSON{Info: m.Info, Subject: m.Subject, Parts: m.Parts})
+}
+
+// UnmarshalJSON reads an object written by MarshalJSON
+func (m *Message) UnmarshalJSON(data []byte) error {
+	var v messageJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = Message{Info: v.Info, Subject: v.Subject, Parts: v.Parts}
+	return nil
+}
+
+var (
+	// separatorLine starts a forwarded or quoted message in many mail clients,
+	// e.g. "-----Original Message-----" or "---------- Forwarded message ---------"
+	separatorLine = regexp.MustCompile(`(?i)^\s*-{2,}\s*(original message|forwarded message|` +
+		`ursprüngliche nachricht|weitergeleitete nachricht|message d'origine|message transféré|` +
+		`mensaje original|mensaje reenviado|messaggio originale|messaggio inoltrato|` +
+		`oorspronkelijk bericht|doorgestuurd bericht|mensagem original|mensagem encaminhada)\s*-{2,}\s*$`)
+	// underscoreLine starts a quoted message in Outlook when a From: header follows it
+	underscoreLine = regexp.MustCompile(`^\s*_{10,}\s*$`)
+	fromHeader     = regexp.MustCompile(`(?i)^\s*\*?(from|von|de|da|van)\s*:`)
+)
+
+// FromMessage detects the language of an RFC 5322 email and of each of its text parts
+//
+// Quoted replies and signatures are dropped, so only the new content counts.
+// The Info of the message reports the charset of its parts when they share one
+func FromMessage(reader io.Reader) (Message, error) {
+	return defaultDetector.FromMessage(reader)
+}
+
+// FromMessage detects the language of an RFC 5322 email and of each of its text parts
+func (d Detector) FromMessage(reader io.Reader) (Message, error) {
+	msg, err := mail.ReadMessage(reader)
+	if err != nil {
+		return Message{Info: Info{lang: undetermined, langTag: undeterminedTag}}, err
+	}
+
+	decoder := mime.WordDecoder{CharsetReader: charsetReader}
+	subject, err := decoder.DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		subject = msg.Header.Get("Subject")
+	}
+
+	result := Message{Subject: subject}
+	if err := d.walkPart(msg.Header, msg.Body, &result); err != nil {
+		result.Info = Info{lang: undetermined, langTag: undeterminedTag}
+		return result, err
+	}
+
+	// alternative HTML parts repeat the plain text, so they only count when
+	// there is no plain text
+	var plain, html []MessagePart
+	for _, part := range result.Parts {
+		if part.ContentType == "text/html" {
+			html = append(html, part)
+		} else {
+			plain = append(plain, part)
+		}
+	}
+	if len(plain) == 0 {
+		plain = html
+	}
+
+	texts := make([]string, len(plain))
+	for i, part := range plain {
+		texts[i] = part.Text
+	}
+	result.Info, err = d.FromString(strings.Join(texts, "\n"))
+	result.Info.charset = partsCharset(plain)
+	return result, err
+}
+
+// partsCharset returns the charset the parts share, or "" when they were
+// written in different charsets
+func partsCharset(parts []MessagePart) string {
+	var charset string
+	for i, part := range parts {
+		if i > 0 && part.Info.charset != charset {
+			return ""
+		}
+		charset = part.Info.charset
+	}
+	return charset
+}
+
+// partHeader is satisfied by both mail.Header and textproto.MIMEHeader
+type partHeader interface {
+	Get(key string) string
+}
+
+func (d Detector) walkPart(header partHeader, body io.Reader, result *Message) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := d.walkPart(part.Header, part, result); err != nil {
+				return err
+			}
+		}
+	}
+
+	disposition, _, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	if !strings.HasPrefix(mediaType, "text/") || disposition == "attachment" {
+		return nil
+	}
+
+	b, err := ioutil.ReadAll(transferDecoder(header.Get("Content-Transfer-Encoding"), body))
+	if err != nil {
+		return err
+	}
+	charset, text := decodeCharset(b, params["charset"])
+	if mediaType == "text/html" {
+		text, _ = extractHTML(text)
+	}
+	text = newContent(text)
+
+	info, err := d.FromString(text)
+	info.charset = charset
+	result.Parts = append(result.Parts, MessagePart{ContentType: mediaType, Text: text, Info: info})
+	return err
+}
+
+// transferDecoder undoes base64 and quoted-printable transfer encodings;
+// multipart.Reader already decodes quoted-printable parts itself
+func transferDecoder(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	}
+	return body
+}
+
+// decodeCharset decodes b from its declared charset, falling back to
+// detecting the charset when it is missing or unknown
+func decodeCharset(b []byte, name string) (string, string) {
+	if enc, err := htmlindex.Get(name); err == nil {
+		if text, err := enc.NewDecoder().Bytes(b); err == nil {
+			canonical, _ := htmlindex.Name(enc)
+			return canonical, string(text)
+		}
+	}
+	cs, text := decode(b)
+	return cs.name, text
+}
+
+func charsetReader(name string, input io.Reader) (io.Reader, error) {
+	enc, err := htmlindex.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return enc.NewDecoder().Reader(input), nil
+}
+
+// newContent drops quoted lines, the attribution line introducing them, and
+// everything from a signature or forwarded message separator onwards
+func newContent(text string) string {
+	lines := strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n")
+
+	var sb strings.Builder
+	for i, line := range lines {
+		if line == "-- " || line == "--" || isSeparator(lines, i) {
+			break
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), ">") || isAttribution(lines, i) {
+			continue
+		}
+		sb.WriteString(line)
+		sb.WriteByte('\n')
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// isSeparator reports whether line i starts a forwarded or quoted message; a
+// line of dashes or underscores on its own is a horizontal rule
+func isSeparator(lines []string, i int) bool {
+	if separatorLine.MatchString(lines[i]) {
+		return true
+	}
+	if !underscoreLine.MatchString(lines[i]) {
+		return false
+	}
+	for _, next := range lines[i+1:] {
+		if strings.TrimSpace(next) != "" {
+			return fromHeader.MatchString(next)
+		}
+	}
+	return false
+}
+
+// isAttribution reports whether line i introduces a quoted block, as in
+// "On Mon, 1 Jan 2018, Jane wrote:"
+func isAttribution(lines []string, i int) bool {
+	if !strings.HasSuffix(strings.TrimSpace(lines[i]), ":") {
+		return false
+	}
+	for _, next := range lines[i+1:] {
+		if strings.TrimSpace(next) != "" {
+			return strings.HasPrefix(strings.TrimSpace(next), ">")
+		}
+	}
+	return false
+}
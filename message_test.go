@@ -0,0 +1,124 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+const multipartMessage = "From: Jean Dupont <jean@example.com>\r\n" +
+	"To: support@example.com\r\n" +
+	"Subject: =?UTF-8?Q?Probl=C3=A8me_de_connexion?=\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/mixed; boundary=\"outer\"\r\n" +
+	"\r\n" +
+	"--outer\r\n" +
+	"Content-Type: multipart/alternative; boundary=\"inner\"\r\n" +
+	"\r\n" +
+	"--inner\r\n" +
+	"Content-Type: text/plain; charset=\"iso-8859-1\"\r\n" +
+	"Content-Transfer-Encoding: quoted-printable\r\n" +
+	"\r\n" +
+	"Bonjour, je n'arrive plus =E0 me connecter depuis la mise =E0 jour d'hier.\r\n" +
+	"Pouvez-vous m'aider s'il vous pla=EEt ?\r\n" +
+	"\r\n" +
+	"Le 3 mars 2024, Support a =E9crit :\r\n" +
+	"> Thank you for contacting us. Please describe the problem you are having.\r\n" +
+	"> We will get back to you as soon as possible.\r\n" +
+	"\r\n" +
+	"-- \r\n" +
+	"Jean Dupont\r\n" +
+	"Sent from my phone\r\n" +
+	"--inner\r\n" +
+	"Content-Type: text/html; charset=\"utf-8\"\r\n" +
+	"Content-Transfer-Encoding: base64\r\n" +
+	"\r\n" +
+	"PHA+Qm9uam91ciwgamUgbidhcnJpdmUgcGx1cyDDoCBtZSBjb25uZWN0ZXIgZGVwdWlzIGxhIG1p\r\n" +
+	"c2Ugw6Agam91ciBkJ2hpZXIuPC9wPg==\r\n" +
+	"--inner--\r\n" +
+	"--outer\r\n" +
+	"Content-Type: text/plain; charset=\"utf-8\"\r\n" +
+	"Content-Disposition: attachment; filename=\"log.txt\"\r\n" +
+	"\r\n" +
+	"error: connection refused while reading the configuration file\r\n" +
+	"--outer--\r\n"
+
+func TestFromMessage(t *testing.T) {
+	msg, err := FromMessage(strings.NewReader(multipartMessage))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "fr", msg.LanguageCode())
+	assert.Equal(t, "Problème de connexion", msg.Subject)
+	assert.Equal(t, "windows-1252", msg.Charset())
+	assert.Equal(t, 2, len(msg.Parts))
+
+	plain := msg.Parts[0]
+	assert.Equal(t, "text/plain", plain.ContentType)
+	assert.Equal(t, "Bonjour, je n'arrive plus à me connecter depuis la mise à jour d'hier.\nPouvez-vous m'aider s'il vous plaît ?", plain.Text)
+	assert.Equal(t, "fr", plain.Info.LanguageCode())
+	assert.Equal(t, "windows-1252", plain.Info.Charset())
+
+	html := msg.Parts[1]
+	assert.Equal(t, "text/html", html.ContentType)
+	assert.Equal(t, "Bonjour, je n'arrive plus à me connecter depuis la mise à jour d'hier.", html.Text)
+	assert.Equal(t, "fr", html.Info.LanguageCode())
+	assert.Equal(t, "utf-8", html.Info.Charset())
+}
+
+func TestFromMessageSinglePart(t *testing.T) {
+	raw := "Subject: Anfrage\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"Content-Transfer-Encoding: base64\r\n" +
+		"\r\n" +
+		"QWxsZSBNZW5zY2hlbiBzaW5kIGZyZWkgdW5kIGdsZWljaCBhbiBXw7xyZGUgdW5kIFJlY2h0ZW4g\r\n" +
+		"Z2Vib3Jlbi4=\r\n"
+	msg, err := FromMessage(strings.NewReader(raw))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "de", msg.LanguageCode())
+	assert.Equal(t, "utf-8", msg.Charset())
+	assert.Equal(t, 1, len(msg.Parts))
+	assert.Equal(t, "Alle Menschen sind frei und gleich an Würde und Rechten geboren.", msg.Parts[0].Text)
+}
+
+func TestFromMessageInvalid(t *testing.T) {
+	_, err := FromMessage(strings.NewReader("not a message"))
+
+	assert.NotNil(t, err)
+}
+
+func TestNewContent(t *testing.T) {
+	text := "Sounds good, see you then.\n" +
+		"\n" +
+		"-----Original Message-----\n" +
+		"From: Someone\n" +
+		"Wir sehen uns morgen um zehn Uhr.\n"
+
+	assert.Equal(t, "Sounds good, see you then.", newContent(text))
+	assert.Equal(t, "Agenda:\n\n1. budget", newContent("Agenda:\n\n1. budget\n> quoted"))
+}
+
+func TestNewContentKeepsHorizontalRules(t *testing.T) {
+	text := "Quarterly results\n" +
+		"=================\n" +
+		"Revenue grew in every region.\n" +
+		"\n" +
+		"-----\n" +
+		"Costs stayed flat.\n" +
+		"__________\n" +
+		"Next review in June."
+
+	assert.Equal(t, text, newContent(text))
+}
+
+func TestNewContentSeparators(t *testing.T) {
+	for _, separator := range []string{
+		"---------- Forwarded message ---------",
+		"-----Ursprüngliche Nachricht-----",
+		"________________________________\nFrom: Someone <someone@example.com>",
+		"________________________________\n\nVon: Jemand",
+	} {
+		text := "Sounds good, see you then.\n\n" + separator + "\nWir sehen uns morgen um zehn Uhr.\n"
+		assert.Equal(t, "Sounds good, see you then.", newContent(text), separator)
+	}
+}
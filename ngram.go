@@ -0,0 +1,236 @@
+package getlang
+
+import (
+	"errors"
+	"sort"
+	"unicode"
+)
+
+// Order is an n-gram length scored by a Model and the weight of its matches
+type Order struct {
+	// N is the number of characters in each n-gram, from 1 to 5
+	N int
+	// Weight multiplies the points of every matching n-gram
+	Weight int
+}
+
+// Model is the set of n-gram orders a Detector scores
+//
+// The unigram, bigram, quadgram and 5-gram profiles are built from the
+// translated messages of free software, by makeprofiles.go. Chinese and
+// Japanese have unigram profiles of their Han characters, which tell Han text
+// in the two apart. Languages with too little translated text fall back to
+// profiles drawn from their trigrams: the most frequent characters and pairs
+// of characters, and the quadgrams and 5-grams made only of profile trigrams
+type Model []Order
+
+// TrigramModel scores trigrams alone; it is the default
+func TrigramModel() Model {
+	return Model{{N: 3, Weight: 1}}
+}
+
+// ShortTextModel adds unigrams and bigrams, which help with single words and
+// with text written only in Han characters
+func ShortTextModel() Model {
+	return Model{{N: 1, Weight: 1}, {N: 2, Weight: 2}, {N: 3, Weight: 3}}
+}
+
+// LongTextModel adds quadgrams, which help with sentences and longer text
+func LongTextModel() Model {
+	return Model{{N: 3, Weight: 1}, {N: 4, Weight: 1}}
+}
+
+// ErrInvalidModel is returned by a Detector whose Model has an order outside
+// 1 to 5 or a weight below 1
+var ErrInvalidModel = errors.New("getlang: model orders need N from 1 to 5 and a positive weight")
+
+const maxOrder int = 5
+const unigramProfileSize int = 40
+const bigramProfileSize int = 150
+
+func (m Model) valid() bool {
+	for _, o := range m {
+		if o.N < 1 || o.N > maxOrder || o.Weight < 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// ngram is an n-gram of a text and how often it occurs
+type ngram struct {
+	ngram string
+	count int
+}
+
+// profileSets holds the n-gram sets of each profile in langs and corpusProfiles,
+// indexed by order
+var profileSets = buildProfileSets()
+
+func buildProfileSets() [maxOrder + 1]map[string]map[string]bool {
+	var sets [maxOrder + 1]map[string]map[string]bool
+	for n := 1; n <= maxOrder; n++ {
+		sets[n] = make(map[string]map[string]bool)
+		for k, grams := range corpusProfiles[n] {
+			sets[n][k] = make(map[string]bool)
+			for _, g := range grams {
+				sets[n][k][g] = true
+			}
+		}
+	}
+
+	for k, profile := range langs {
+		sets[3][k] = subProfile(profile, 3, len(profile))
+		for n, size := range map[int]int{1: unigramProfileSize, 2: bigramProfileSize} {
+			if sets[n][k] == nil {
+				sets[n][k] = subProfile(profile, n, size)
+			}
+		}
+		if sets[4][k] == nil {
+			ranks := make(map[string]int)
+			for i, trigram := range profile {
+				ranks[trigram] = i
+			}
+			quadgrams := extendProfile(ranks, ranks)
+			sets[4][k] = topRanked(quadgrams, len(profile))
+			sets[5][k] = topRanked(extendProfile(quadgrams, ranks), len(profile))
+		}
+	}
+	return sets
+}
+
+// subProfile collects the first size n-grams found in the trigrams of a profile,
+// which are ranked most frequent first
+func subProfile(profile []string, n, size int) map[string]bool {
+	set := make(map[string]bool)
+	for _, trigram := range profile {
+		eachNgram([]rune(trigram), n, func(gram string) {
+			if len(set) < size {
+				set[gram] = true
+			}
+		})
+	}
+	return set
+}
+
+// extendProfile returns the n-grams one character longer than those of shorter
+// whose trigrams are all in the profile; each is ranked as its least frequent
+// trigram
+func extendProfile(shorter, trigrams map[string]int) map[string]int {
+	next := make(map[string][]string)
+	for t := range trigrams {
+		r := []rune(t)
+		next[string(r[:2])] = append(next[string(r[:2])], t)
+	}
+
+	longer := make(map[string]int)
+	for g, rank := range shorter {
+		r := []rune(g)
+		for _, t := range next[string(r[len(r)-2:])] {
+			worst := rank
+			if trigrams[t] > worst {
+				worst = trigrams[t]
+			}
+			longer[g+string([]rune(t)[2])] = worst
+		}
+	}
+	return longer
+}
+
+// topRanked returns the size best ranked n-grams
+func topRanked(ranks map[string]int, size int) map[string]bool {
+	grams := make([]string, 0, len(ranks))
+	for g := range ranks {
+		grams = append(grams, g)
+	}
+	sort.Slice(grams, func(i, j int) bool {
+		if ranks[grams[i]] == ranks[grams[j]] {
+			return grams[i] < grams[j]
+		}
+		return ranks[grams[i]] < ranks[grams[j]]
+	})
+
+	set := make(map[string]bool)
+	for _, g := range grams {
+		if len(set) == size {
+			break
+		}
+		set[g] = true
+	}
+	return set
+}
+
+// hasProfile reports whether a language has a profile of order n
+func hasProfile(lang string, n int) bool {
+	_, ok := profileSets[n][lang]
+	return ok
+}
+
+// countedNgrams counts the n-grams of text, which is padded with a space on
+// either side
+func countedNgrams(text string, n int) map[string]int {
+	txt := []rune{' '}
+	for _, r := range text {
+		txt = append(txt, unicode.ToLower(toTrigramChar(r)))
+	}
+	txt = append(txt, ' ')
+
+	ngrams := map[string]int{}
+	eachNgram(txt, n, func(gram string) {
+		ngrams[gram]++
+	})
+	return ngrams
+}
+
+// eachNgram calls fn with the n-grams of txt in order
+//
+// N-grams with two spaces in a row, and unigrams of a space, carry no
+// information and are left out
+func eachNgram(txt []rune, n int, fn func(string)) {
+	for i := 0; i+n <= len(txt); i++ {
+		window := txt[i : i+n]
+		if n == 1 && window[0] == ' ' || hasDoubleSpace(window) {
+			continue
+		}
+		fn(string(window))
+	}
+}
+
+func hasDoubleSpace(runes []rune) bool {
+	for i := 1; i < len(runes); i++ {
+		if runes[i] == ' ' && runes[i-1] == ' ' {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedNgrams returns the n-grams of s, most frequent first
+func sortedNgrams(s string, n int) []ngram {
+	counterMap := countedNgrams(s, n)
+	ngrams := make([]ngram, 0, len(counterMap))
+	for g, count := range counterMap {
+		ngrams = append(ngrams, ngram{g, count})
+	}
+	sort.Slice(ngrams, func(i, j int) bool {
+		if ngrams[i].count == ngrams[j].count {
+			return ngrams[i].ngram < ngrams[j].ngram
+		}
+		return ngrams[i].count > ngrams[j].count
+	})
+	return ngrams
+}
+
+// matchWith adds the weighted count of the n-grams found in the profile of a
+// language to its score, and returns its share of the undetermined score
+func matchWith(langName string, grams []ngram, order Order, matches map[string]int) int {
+	var undeterminedCount int
+	for _, g := range grams {
+		if profileSets[order.N][langName][g.ngram] {
+			matches[langName] += g.count * order.Weight
+		} else {
+			undeterminedCount++
+		}
+	}
+	return order.Weight * undeterminedCount / undeterminedRates[order.N]
+}
@@ -0,0 +1,228 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+// labelled is a text and the code of its language
+// accuracyCorpus holds sentences from the classification tests that need
+// n-gram scoring to tell their language apart
+var accuracyCorpus = []labelled{
+	{"es", "Sostenemos como evidentes estas verdades: que los hombres son creados iguales"},
+	{"pt", "Consideramos estas verdades como autoevidentes, que todos os homens são criados iguais"},
+	{"pl", "Wszyscy ludzie rodzą się wolni i równi w swojej godności i prawach"},
+	{"ro", "Toate ființele umane se nasc libere și egale în demnitate și în drepturi"},
+	{"gl", "A miña nai mercou pan e leite na panadaría da esquina"},
+	{"sl", "Vsi ljudje se rodijo svobodni in imajo enako dostojanstvo in enake pravice"},
+	{"hr", "Sva ljudska bića rađaju se slobodna i jednaka u dostojanstvu i pravima"},
+	{"cs", "Každý má právo na život, svobodu a osobní bezpečnost"},
+	{"sk", "Každý má právo na život, slobodu a osobnú bezpečnosť"},
+	{"ca", "Tot individu té dret a la vida, a la llibertat i a la seguretat de la seva persona"},
+	{"es", "Todo individuo tiene derecho a la vida, a la libertad y a la seguridad de su persona"},
+	{"gl", "Galicia é unha terra de montes, ríos e praias, onde chove moito no inverno"},
+	{"pt", "A Galiza é uma terra de montes, rios e praias, onde chove muito no inverno"},
+	{"sl", "Vsakdo ima pravico do življenja, prostosti in osebne varnosti"},
+	{"hr", "Svatko ima pravo na život, slobodu i osobnu sigurnost"},
+	{"id", "Saya tidak bisa datang karena harus bekerja sampai sore"},
+	{"ms", "Saya tidak boleh datang kerana perlu bekerja sehingga petang"},
+	{"id", "Pemerintah akan membangun rumah sakit baru di kota ini"},
+	{"ms", "Kerajaan akan membina hospital baharu di bandar ini"},
+	{"yo", "Gbogbo eniyan ni a bi ni ominira; iyi ati eto kookan si dogba"},
+	{"ha", "Dukkan ’yan-adam an haife su ne da ’yanci da martaba da hakkoki daidai da kowa"},
+	{"zu", "Bonke abantu bazalwa bekhululekile futhi belingana ngesithunzi nangamalungelo"},
+	{"so", "Dadka oo dhan waxay dhashaan iyagoo xor ah kuna siman xagga sharafta iyo xuquuqda"},
+	{"tr", "Bugün hava çok güzel, dışarı çıkıp yürüyüş yapalım mı?"},
+	{"az", "Bu gün hava çox gözəldir, çölə çıxıb gəzək?"},
+	{"hu", "Minden emberi lény szabadon születik és egyenlő méltósága és joga van"},
+	{"it", "Tutti gli esseri umani nascono liberi ed eguali in dignità e diritti"},
+	{"ru", "Все люди рождаются свободными и равными в своем достоинстве и правах"},
+	{"uk", "Всі люди народжуються вільними і рівними у своїй гідності та правах"},
+	{"fr", "Tous les êtres humains naissent libres et égaux"},
+	{"zh", "Wǒ xiǎng qù Běijīng lǚyóu"},
+	{"zh", "wo xiang qu beijing lvyou, ni ne?"},
+	{"en", "We hold these truths to be self-evident, that all men are created equal"},
+	{"de", "Alle Menschen sind frei und gleich an Würde und Rechten geboren"},
+	{"nl", "Alle mensen worden vrij en gelijk in waardigheid en rechten geboren"},
+	{"fr", "Je voudrais une tasse de café avec du lait et du sucre"},
+}
+
+func TestCountedNgrams(t *testing.T) {
+	assert.Equal(t, map[string]int{"a": 2, "b": 1, "c": 1}, countedNgrams("Ab, ca", 1))
+	assert.Equal(t, map[string]int{" a": 1, "ab": 1, "b ": 1, " c": 1, "ca": 1, "a ": 1}, countedNgrams("Ab, ca", 2))
+	assert.Equal(t, map[string]int{" ab": 1, "ab ": 1, "b c": 1, " c ": 1}, countedNgrams("ab c", 3))
+	assert.Equal(t, map[string]int{" ab ": 1, "ab c": 1, "b c ": 1}, countedNgrams("ab c", 4))
+	assert.Empty(t, countedNgrams("", 3))
+}
+
+func TestSubProfile(t *testing.T) {
+	profile := []string{" th", "the", "he "}
+
+	assert.Equal(t, map[string]bool{"t": true, "h": true}, subProfile(profile, 1, 2))
+	assert.Equal(t, map[string]bool{" t": true, "th": true, "he": true, "e ": true}, subProfile(profile, 2, 10))
+}
+
+func TestExtendProfile(t *testing.T) {
+	ranks := map[string]int{" th": 0, "the": 1, "he ": 2, "hem": 3}
+	quadgrams := extendProfile(ranks, ranks)
+
+	assert.Equal(t, map[string]int{" the": 1, "the ": 2, "them": 3}, quadgrams)
+	assert.Equal(t, map[string]int{" the ": 2, " them": 3}, extendProfile(quadgrams, ranks))
+	assert.Equal(t, map[string]bool{" the": true, "the ": true}, topRanked(quadgrams, 2))
+}
+
+func TestProfiles(t *testing.T) {
+	for k := range corpusProfiles[4] {
+		assert.Equal(t, len(corpusProfiles[4][k]), len(profileSets[4][k]), k)
+	}
+	assert.Equal(t, len(ms), len(profileSets[4]["ms"]))
+	assert.Equal(t, len(ms), len(profileSets[5]["ms"]))
+
+	assert.True(t, hasProfile("zh", 1))
+	assert.True(t, hasProfile("ja", 1))
+	assert.False(t, hasProfile("zh", 3))
+	for n := 1; n <= maxOrder; n++ {
+		assert.True(t, hasProfile("en", n))
+	}
+}
+
+func TestInvalidModel(t *testing.T) {
+	for _, model := range []Model{{{N: 0, Weight: 1}}, {{N: 6, Weight: 1}}, {{N: 3, Weight: 0}}} {
+		info, err := Detector{Model: model}.FromString("the quick brown fox")
+
+		assert.Equal(t, ErrInvalidModel, err)
+		assert.Equal(t, "und", info.LanguageCode())
+	}
+}
+
+func TestModelsClassifyFullSentences(t *testing.T) {
+	for _, model := range []Model{TrigramModel(), ShortTextModel(), LongTextModel()} {
+		assert.Equal(t, len(accuracyCorpus), correctlyClassified(Detector{Model: model}, 0), model)
+	}
+}
+
+// hanCorpus holds short Chinese and Japanese texts written only in Han characters
+var hanCorpus = []labelled{
+	{"zh", "我们说话"},
+	{"zh", "这个问题"},
+	{"zh", "没有时间"},
+	{"zh", "电话号码"},
+	{"zh", "飞机场"},
+	{"zh", "买东西"},
+	{"ja", "東京駅"},
+	{"ja", "天気予報"},
+	{"ja", "図書館"},
+	{"ja", "鉄道会社"},
+	{"ja", "関西地方"},
+	{"ja", "大学入試"},
+}
+
+// heldOut holds sentences and runs of Han characters from the catalogs that
+// makeprofiles.go leaves out of the profiles; the language of a Han run has a
+// "-Hani" suffix
+func heldOut(t *testing.T) (sentences, han []labelled) {
+	b, err := ioutil.ReadFile("testdata/heldout.txt")
+	assert.Nil(t, err)
+
+	for _, line := range strings.Split(strings.TrimSpace(string(b)), "\n") {
+		fields := strings.SplitN(line, "\t", 2)
+		lang := strings.SplitN(fields[0], "-", 2)[0]
+		if strings.HasSuffix(fields[0], "-Hani") {
+			han = append(han, labelled{lang, fields[1]})
+		} else {
+			sentences = append(sentences, labelled{lang, fields[1]})
+		}
+	}
+	return sentences, han
+}
+
+type labelled struct {
+	lang, text string
+}
+
+// TestModelAccuracy compares the models on the first words of each sentence,
+// on Han text, and on held-out sentences and Han runs; run with -v to see the
+// table
+func TestModelAccuracy(t *testing.T) {
+	sentences, han := heldOut(t)
+	detectors := []struct {
+		name     string
+		detector Detector
+	}{
+		{"unigrams", Detector{Model: Model{{N: 1, Weight: 1}}}},
+		{"bigrams", Detector{Model: Model{{N: 2, Weight: 1}}}},
+		{"trigrams", Detector{Model: TrigramModel()}},
+		{"quadgrams", Detector{Model: Model{{N: 4, Weight: 1}}}},
+		{"5-grams", Detector{Model: Model{{N: 5, Weight: 1}}}},
+		{"short text", Detector{Model: ShortTextModel()}},
+		{"long text", Detector{Model: LongTextModel()}},
+		{"1 to 4", Detector{Model: Model{{N: 1, Weight: 1}, {N: 2, Weight: 1}, {N: 3, Weight: 3}, {N: 4, Weight: 3}}}},
+		{"trigrams+words", Detector{Model: TrigramModel(), Words: true}},
+		{"short text+words", Detector{Model: ShortTextModel(), Words: true}},
+	}
+	for _, d := range detectors {
+		t.Logf("%-16s 1 word %2d/%d, 2 words %2d/%d, 3 words %2d/%d, sentence %2d/%d, Han %2d/%d, "+
+			"held-out 2 words %3d/%d, sentence %3d/%d, Han %2d/%d", d.name,
+			correctlyClassified(d.detector, 1), len(accuracyCorpus),
+			correctlyClassified(d.detector, 2), len(accuracyCorpus),
+			correctlyClassified(d.detector, 3), len(accuracyCorpus),
+			correctlyClassified(d.detector, 0), len(accuracyCorpus),
+			correctlyLabelled(d.detector, hanCorpus, 0), len(hanCorpus),
+			correctlyLabelled(d.detector, sentences, 2), len(sentences),
+			correctlyLabelled(d.detector, sentences, 0), len(sentences),
+			correctlyLabelled(d.detector, han, 0), len(han))
+	}
+
+	var short, trigrams int
+	for words := 1; words <= 3; words++ {
+		short += correctlyClassified(Detector{Model: ShortTextModel()}, words)
+		trigrams += correctlyClassified(Detector{}, words)
+	}
+	assert.True(t, short > trigrams)
+	assert.True(t, correctlyLabelled(Detector{Model: ShortTextModel()}, hanCorpus, 0) > correctlyLabelled(Detector{}, hanCorpus, 0))
+	assert.True(t, correctlyLabelled(Detector{Model: ShortTextModel()}, han, 0) > correctlyLabelled(Detector{}, han, 0))
+	assert.True(t, correctlyLabelled(Detector{Model: LongTextModel()}, sentences, 0) > correctlyLabelled(Detector{}, sentences, 0))
+}
+
+// correctlyClassified counts the corpus sentences whose first words are
+// classified correctly; zero words uses the whole sentence
+func correctlyClassified(d Detector, words int) int {
+	return correctlyLabelled(d, accuracyCorpus, words)
+}
+
+// correctlyLabelled counts the texts whose first words are classified as
+// their language; zero words uses the whole text
+func correctlyLabelled(d Detector, texts []labelled, words int) int {
+	var correct int
+	for _, c := range texts {
+		text := c.text
+		if fields := strings.Fields(text); words > 0 && len(fields) > words {
+			text = strings.Join(fields[:words], " ")
+		}
+		if info, _ := d.FromString(text); info.LanguageCode() == c.lang {
+			correct++
+		}
+	}
+	return correct
+}
+
+func benchmarkModel(b *testing.B, model Model) {
+	d := Detector{Model: model}
+	for i := 0; i < b.N; i++ {
+		d.FromString("We hold these truths to be self-evident, that all men are created equal, that they are endowed by their Creator with certain unalienable Rights")
+	}
+}
+
+func BenchmarkTrigramModel(b *testing.B) {
+	benchmarkModel(b, TrigramModel())
+}
+
+func BenchmarkShortTextModel(b *testing.B) {
+	benchmarkModel(b, ShortTextModel())
+}
+
+func BenchmarkLongTextModel(b *testing.B) {
+	benchmarkModel(b, LongTextModel())
+}
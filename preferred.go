@@ -0,0 +1,75 @@
+package getlang
+
+import (
+	"golang.org/x/text/language"
+)
+
+// preferredBonus is added to the score of the reader's most preferred language;
+// each later preference gets half the bonus of the one before it
+const preferredBonus int = 2
+
+// ParseAcceptLanguage returns the languages of an Accept-Language header in
+// order of preference, for use as Detector.Preferred
+func ParseAcceptLanguage(header string) ([]language.Tag, error) {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	return tags, err
+}
+
+// RegionLanguage returns the most likely language spoken in a region, given as
+// an ISO 3166-1 or UN M.49 code such as "CH" or "419", for use as
+// Detector.Preferred when the reader's country is all that is known
+//
+// It returns und for regions without a likely language, such as Antarctica
+func RegionLanguage(region string) (language.Tag, error) {
+	r, err := language.ParseRegion(region)
+	if err != nil {
+		return language.Und, err
+	}
+	tag, err := language.Compose(language.Und, r)
+	if err != nil {
+		return language.Und, err
+	}
+	base, confidence := tag.Base()
+	if confidence == language.No {
+		return language.Und, nil
+	}
+	return language.Compose(base, r)
+}
+
+// addPreferred raises the scores of the preferred languages the text already
+// gave points to, so a preference never brings in a language of another
+// script or turns letterless text into a language
+//
+// Adding to a score multiplies the odds softMax gives that language by a
+// constant factor, so preferences tip short texts and hardly move long ones
+func addPreferred(langMatches map[string]int, preferred []language.Tag) {
+	bonus := preferredBonus
+	seen := make(map[string]bool)
+	for _, tag := range preferred {
+		if bonus == 0 {
+			return
+		}
+		k, ok := languageKey(tag)
+		if !ok || seen[k] {
+			continue
+		}
+		seen[k] = true
+		if langMatches[k] > 0 {
+			langMatches[k] += bonus
+		}
+		bonus /= 2
+	}
+}
+
+// Match picks the best of the locales an application supports for the detected
+// language, using the language.Matcher built from that list
+//
+// It returns what the matcher does: the matched tag, the index of the supported
+// tag and how confident the match is. An undetermined language gets the
+// matcher's default, the first supported tag, with language.No confidence
+func (info Info) Match(m language.Matcher) (language.Tag, int, language.Confidence) {
+	if info.lang == undetermined {
+		return m.Match()
+	}
+	return m.Match(info.Tag())
+}
@@ -0,0 +1,105 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+func TestPreferredTipsShortText(t *testing.T) {
+	assert.NotEqual(t, "pt", FromString("obrigado").LanguageCode())
+
+	info, _ := Detector{Preferred: []language.Tag{language.Portuguese}}.FromString("obrigado")
+	assert.Equal(t, "pt", info.LanguageCode())
+}
+
+func TestPreferredFromAcceptLanguage(t *testing.T) {
+	preferred, err := ParseAcceptLanguage("pt-BR,pt;q=0.9,en;q=0.8")
+	assert.Nil(t, err)
+
+	info, _ := Detector{Preferred: preferred}.FromString("a casa")
+	assert.Equal(t, "pt", info.LanguageCode())
+}
+
+func TestPreferredDoesNotOverrideLongText(t *testing.T) {
+	text := "Je voudrais une tasse de café avec du lait et du sucre, s'il vous plaît"
+	info, _ := Detector{Preferred: []language.Tag{language.German, language.Portuguese}}.FromString(text)
+
+	assert.Equal(t, "fr", info.LanguageCode())
+	assert.True(t, info.Confidence() > 0.9)
+}
+
+func TestAddPreferredHalvesBonus(t *testing.T) {
+	langMatches := map[string]int{"und": 1, "de": 3, "fr": 3, "it": 3, "nl": 3}
+	addPreferred(langMatches, []language.Tag{
+		language.MustParse("de-CH"), language.German, language.French, language.Und, language.Italian, language.Dutch,
+	})
+
+	assert.Equal(t, map[string]int{"und": 1, "de": 5, "fr": 4, "it": 3, "nl": 3}, langMatches)
+}
+
+func TestAddPreferredSkipsUnscoredLanguages(t *testing.T) {
+	langMatches := map[string]int{"und": 1, "en": 3, "fr": 3}
+	addPreferred(langMatches, []language.Tag{language.Russian, language.French})
+
+	assert.Equal(t, map[string]int{"und": 1, "en": 3, "fr": 4}, langMatches)
+}
+
+func TestPreferredNeedsEvidence(t *testing.T) {
+	russian := Detector{Preferred: []language.Tag{language.Russian}}
+	for _, text := range []string{"", "12345", "!!!"} {
+		info, _ := russian.FromString(text)
+		assert.Equal(t, "und", info.LanguageCode(), text)
+	}
+
+	info, _ := russian.FromString("ok")
+	assert.NotEqual(t, "ru", info.LanguageCode())
+
+	info, _ = Detector{Preferred: []language.Tag{language.Japanese}}.FromString("ok")
+	assert.NotEqual(t, "ja", info.LanguageCode())
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tags, err := ParseAcceptLanguage("en;q=0.5, fr-CH, fr;q=0.9")
+
+	assert.Nil(t, err)
+	assert.Equal(t, []language.Tag{language.MustParse("fr-CH"), language.French, language.English}, tags)
+
+	_, err = ParseAcceptLanguage("en;q=x")
+	assert.NotNil(t, err)
+}
+
+func TestRegionLanguage(t *testing.T) {
+	tests := map[string]string{
+		"CH":  "de-CH",
+		"BE":  "nl-BE",
+		"BR":  "pt-BR",
+		"419": "es-419",
+		"AQ":  "und",
+	}
+	for region, expected := range tests {
+		tag, err := RegionLanguage(region)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, tag.String(), region)
+	}
+
+	_, err := RegionLanguage("not a region")
+	assert.NotNil(t, err)
+}
+
+func TestInfoMatch(t *testing.T) {
+	supported := []language.Tag{language.English, language.MustParse("fr-CA"), language.BrazilianPortuguese}
+	m := language.NewMatcher(supported)
+
+	_, index, confidence := FromString("Je voudrais une tasse de café avec du lait et du sucre, s'il vous plaît").Match(m)
+	assert.Equal(t, 1, index)
+	assert.Equal(t, language.High, confidence)
+
+	_, index, confidence = FromString("Eu gostaria de uma xícara de café com leite e açúcar, por favor").Match(m)
+	assert.Equal(t, 2, index)
+	assert.Equal(t, language.Exact, confidence)
+
+	_, index, confidence = FromString("").Match(m)
+	assert.Equal(t, 0, index)
+	assert.Equal(t, language.No, confidence)
+}
@@ -0,0 +1,185 @@
+package getlang
+
+import (
+	"golang.org/x/text/language"
+	"math"
+	"sort"
+	"sync/atomic"
+)
+
+// priorWeight scales the log odds a prior adds to a score; the scores are not
+// log-likelihoods, and counting priors in full would drown short texts
+const priorWeight float64 = 0.5
+
+// Priors maps language codes to how likely a text is to be in that language
+// before it is read
+//
+// The values need not add up to 1. Keys are the codes Info.BCP47 returns, such
+// as "hi" or "hi-Latn", or other BCP 47 tags: a tag without a script, such as
+// "sr" or "pt-BR", covers every script of its language and adds up with other
+// tags of the same language, and an exact key wins over it. Languages missing
+// from the map are as likely as the least likely one in it
+type Priors map[string]float64
+
+// DefaultPriors follows the share of web content written in each language,
+// with a floor of 0.1%
+var DefaultPriors = Priors{
+	"en": 0.49, "es": 0.06, "de": 0.056, "ja": 0.049, "fr": 0.044,
+	"ru": 0.042, "pt": 0.038, "it": 0.026, "nl": 0.02, "pl": 0.017,
+	"tr": 0.017, "zh": 0.012, "vi": 0.011, "cs": 0.009, "ko": 0.009,
+	"id": 0.008, "ar": 0.006, "uk": 0.006, "ro": 0.006, "el": 0.005,
+	"hu": 0.005, "th": 0.004, "he": 0.003, "sk": 0.003, "sr": 0.002,
+	"hr": 0.002, "sl": 0.002, "ca": 0.002, "hi": 0.002, "ms": 0.002,
+	"az": 0.001, "bn": 0.001, "ka": 0.001, "hy": 0.001, "tl": 0.001,
+	"uz": 0.001, "gl": 0.001, "sw": 0.001, "ta": 0.001,
+}
+
+// EstimatePriors turns the number of texts seen in each language, such as the
+// labels of your own traffic, into Priors
+//
+// Every supported language gets one extra count, so a language missing from
+// the sample is unlikely rather than impossible
+func EstimatePriors(counts map[language.Tag]int) Priors {
+	priors := make(Priors)
+	for _, k := range supportedLanguages() {
+		priors[k] = 1
+	}
+	for tag, n := range counts {
+		if n <= 0 {
+			continue
+		}
+		for _, k := range priorKeys(tag.String()) {
+			priors[k] += float64(n)
+		}
+	}
+
+	var total float64
+	for _, v := range priors {
+		total += v
+	}
+	for k := range priors {
+		priors[k] /= total
+	}
+	return priors
+}
+
+// addPriors raises the score of every language the text already matches by
+// the weighted log of how much likelier it is than the least likely language
+//
+// Languages without a match are left alone, so priors never invent a language
+// for text that has none of its letters or n-grams
+func addPriors(langMatches map[string]int, priors Priors) {
+	floor := math.Inf(1)
+	for _, p := range priors {
+		if p > 0 && p < floor {
+			floor = p
+		}
+	}
+	if math.IsInf(floor, 1) {
+		return
+	}
+
+	resolved := priors.cachedResolved()
+	for k, v := range langMatches {
+		if v == 0 || k == undetermined {
+			continue
+		}
+		if p := resolved[k]; p > floor {
+			langMatches[k] += int(math.Round(priorWeight * math.Log(p/floor)))
+		}
+	}
+}
+
+// lastResolved holds the resolvedPriors of the Priors addPriors saw last, so
+// a Detector that keeps its Priors resolves them once rather than on every
+// detection
+var lastResolved atomic.Value
+
+type resolvedPriors struct {
+	priors   Priors
+	resolved map[string]float64
+}
+
+// cachedResolved returns resolved, reusing the last result while the priors
+// hold the same values
+func (p Priors) cachedResolved() map[string]float64 {
+	if last, ok := lastResolved.Load().(resolvedPriors); ok && last.priors.equal(p) {
+		return last.resolved
+	}
+	copied := make(Priors, len(p))
+	for k, v := range p {
+		copied[k] = v
+	}
+	resolved := p.resolved()
+	lastResolved.Store(resolvedPriors{priors: copied, resolved: resolved})
+	return resolved
+}
+
+func (p Priors) equal(other Priors) bool {
+	if len(p) != len(other) {
+		return false
+	}
+	for k, v := range p {
+		if w, ok := other[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+// resolved maps the priors onto the language keys they cover
+func (p Priors) resolved() map[string]float64 {
+	tags := make([]string, 0, len(p))
+	for k := range p {
+		tags = append(tags, k)
+	}
+	sort.Strings(tags)
+
+	exact := make(map[string]float64)
+	resolved := make(map[string]float64)
+	for _, tag := range tags {
+		if isLanguageKey(tag) {
+			exact[tag] = p[tag]
+			continue
+		}
+		for _, k := range priorKeys(tag) {
+			resolved[k] += p[tag]
+		}
+	}
+	for k, v := range exact {
+		resolved[k] = v
+	}
+	return resolved
+}
+
+// priorKeys returns the language keys a prior covers: the key itself, every
+// script of a language given without one, or the closest key to any other tag
+func priorKeys(tag string) []string {
+	if isLanguageKey(tag) {
+		return []string{tag}
+	}
+	raw, err := language.Raw.Parse(tag)
+	if err != nil {
+		return nil
+	}
+	base, script, _ := raw.Raw()
+	if script.String() == "Zzzz" {
+		var keys []string
+		for _, s := range supported().langs {
+			if s.base == base {
+				keys = append(keys, s.key)
+			}
+		}
+		if keys != nil {
+			return keys
+		}
+	}
+	if k, ok := languageKey(language.Make(tag)); ok {
+		return []string{k}
+	}
+	return nil
+}
+
+func isLanguageKey(k string) bool {
+	return supported().set[k]
+}
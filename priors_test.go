@@ -0,0 +1,98 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+func TestPriorsTipShortText(t *testing.T) {
+	assert.NotEqual(t, "pt", FromString("obrigado").LanguageCode())
+
+	info, _ := Detector{Priors: Priors{"pt": 0.99, "so": 0.01}}.FromString("obrigado")
+	assert.Equal(t, "pt", info.LanguageCode())
+}
+
+func TestDefaultPriorsKeepLongText(t *testing.T) {
+	text := "Je voudrais une tasse de café avec du lait et du sucre, s'il vous plaît"
+	info, _ := Detector{Priors: DefaultPriors}.FromString(text)
+
+	assert.Equal(t, "fr", info.LanguageCode())
+	assert.True(t, info.Confidence() > 0.9)
+}
+
+func TestPriorsKeepEmptyTextUndetermined(t *testing.T) {
+	info, _ := Detector{Priors: DefaultPriors}.FromString("")
+
+	assert.Equal(t, "und", info.LanguageCode())
+}
+
+func TestAddPriors(t *testing.T) {
+	langMatches := map[string]int{"en": 3, "fr": 3, "de": 0, "it": 2, "und": 1}
+	addPriors(langMatches, Priors{"en": 0.5, "fr": 0.05, "de": 0.5, "nl": 0.005})
+
+	assert.Equal(t, map[string]int{"en": 5, "fr": 4, "de": 0, "it": 2, "und": 1}, langMatches)
+}
+
+func TestAddEmptyPriors(t *testing.T) {
+	langMatches := map[string]int{"en": 3, "und": 1}
+	addPriors(langMatches, Priors{})
+
+	assert.Equal(t, map[string]int{"en": 3, "und": 1}, langMatches)
+}
+
+func TestEstimatePriors(t *testing.T) {
+	priors := EstimatePriors(map[language.Tag]int{
+		language.English:             120,
+		language.BrazilianPortuguese: 30,
+		language.Portuguese:          10,
+		language.MustParse("tlh"):    5,
+	})
+
+	var total float64
+	for _, p := range priors {
+		total += p
+	}
+	assert.InDelta(t, 1.0, total, 1e-9)
+	assert.True(t, priors["en"] > priors["pt"])
+	assert.InDelta(t, 41.0, priors["pt"]/priors["fr"], 1e-9)
+	assert.True(t, priors["fr"] > 0)
+	assert.NotContains(t, priors, "tlh")
+}
+
+func TestPriorForLanguageCoversItsScripts(t *testing.T) {
+	assert.Equal(t, "sl", FromString("kako si").LanguageCode())
+
+	info, _ := Detector{Priors: Priors{"sr": 0.1, "sl": 0.001}}.FromString("kako si")
+	assert.Equal(t, "sr-Latn", info.Tag().String())
+}
+
+func TestResolvedPriors(t *testing.T) {
+	resolved := Priors{"sr": 0.2, "uz": 0.1, "uz-Cyrl": 0.05, "pt-BR": 0.3, "pt-PT": 0.1, "iw": 0.01, "xx": 0.5}.resolved()
+
+	assert.Equal(t, map[string]float64{
+		"sr-Cyrl": 0.2, "sr-Latn": 0.2,
+		"uz-Cyrl": 0.05, "uz-Latn": 0.1,
+		"pt": 0.4,
+		"he": 0.01,
+	}, resolved)
+}
+
+func TestEstimatePriorsForLanguageWithScripts(t *testing.T) {
+	priors := EstimatePriors(map[language.Tag]int{language.Serbian: 10})
+
+	assert.Equal(t, priors["sr-Latn"], priors["sr-Cyrl"])
+	assert.True(t, priors["sr-Latn"] > priors["hr"])
+}
+
+func TestCachedPriorsFollowChanges(t *testing.T) {
+	priors := Priors{"sr": 0.1, "sl": 0.001}
+	assert.Equal(t, 0.1, priors.cachedResolved()["sr-Latn"])
+
+	priors["sr"] = 0.001
+	priors["sl"] = 0.1
+	assert.Equal(t, 0.001, priors.cachedResolved()["sr-Latn"])
+
+	info, _ := Detector{Priors: priors}.FromString("kako si")
+	assert.Equal(t, "sl", info.LanguageCode())
+}
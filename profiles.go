@@ -4,7 +4,7 @@ var en = []string{" th", "the", "he ", "er ", "ed ", " an", "nd ", " to", " he",
 
 var es = []string{" de", "de ", " la", "os ", "la ", "es ", "e l", " y ", " co", "ión", "ón ", "as ", "los", "o d", " lo", " un", "ad ", "dad", "ent", "s d", " es", "el ", "aci", "cio", "con", "ion", "res", "al ", "com", "ida", "les", "uni", " pr", " re", "ció", "rta", "s y", "tad", "a u", "ale", "cia", "del", "der", "ere", "est", "nci", "nes", "nió", "rec", "s c", " en", "a c", "a d", "a l", "ado", "ech", "nal", "ona", " a ", " ca", " eu", "art", "cho", "esp", "eur", "las", "lib", "n d", "nte", "rop", "s e", "uro", "y d", " el", " li", " so", "ada", "ar ", "ber", "en ", "hos", "n e", "one", "ons", "s a", "s p", "te ", "to ", " pa", " tr", "a e", "car", "do ", "e e", "e s", "enc", "ert", "ia ", "ibe", "ici", "io ", "man", "men", "mo ", "na ", "ntr", "o e", "omo", "sta", "sí ", "ta ", " as", " ba", " fu", " hu", " in", " ju", " mi", " po", " su", "a p", "a y", "así", "cci", "d d", "den", "des", "dos", "e c", "e d", "ers", "hum", "ico", "idi", "ien", "l c", "l p", "mie", "mun", "nta", "omu", "ope", "or ", "par", "pro", "rad", "s m", "sid", "sti", "tal", "ten", "tri", "uma", "una", "ven", "í c", " ci", " di", " se", " va", "a a", "a r", "a s", "act", "ade", "alo", "ame", "ana", "ano", "ant", "ara", "ari", "ble", "bro", "cie", "cip", "cos", "cul", "dam", "das", "dic", "ecc", "eda", "emb", "end", "eni", "fun", "ibu", "iem", "ili", "inc", "ios", "ipi", "ir ", "itu", "iza", "jo ", "l d", "l y", "lid", "lor", "mbr", "n c", "n y", "nac", "nda", "nos", "nti", "nve", "o l", "oci", "ont", "onv", "opa", "ore", "pa ", "pet", "pio", "por", "pri", "r e", "r l", "r u", "re ", "rea", "rib", "rid", "rin", "ro ", "ros", "s f", "s i", "soc", "spe", "tar", "tec", "tic", "tra", "tro", "tua", "ual", "un ", "und", "une", "uri", "val", "y l", " ac", " al", " cr", " fo", " me", " na", " pe", " pu", " qu"}
 
-var pt = []string{"os ", " da", " e ", "da ", " co", " do", "as ", "de ", "e d", "ão ", "dad", "ade", "do ", "es ", " a ", "o d", " de", "o e", "s d", "dos", "ent", "s e", " pr", "con", "res", "a c", "a e", "com", "eit", "ida", "is ", "uni", "ção", " se", " un", "das", "est", "ito", " di", " es", " re", "a u", "ais", "al ", "ião", "niã", "to ", "uro", " pa", "a d", "ada", "dir", "ia ", "nte", "o p", "rei", "s p", " as", " ca", " eu", " pe", "a p", "a s", "ado", "cia", "des", "em ", "eur", "ire", "men", "ra ", "rop", "s c", "ta ", " li", " no", " so", "a a", "art", "esp", "ion", "lib", "o c", "ona", "ons", "par", "pro", "rad", "s a", "sen", "ser", "sta", "te ", "tos", "ura", "çõe", "ões", " in", " um", "ara", "ber", "car", "cio", "e c", "erd", "ibe", "mem", "nal", "no ", "omo", "pei", "pel", "rda", "rta", "s s", "sid", "tad", "tri", "um ", "uma", " ao", " be", " fu", " ju", " me", " na", " o ", " po", " tr", "a o", "aci", "ass", "açã", "ca ", "e a", "e p", "eia", "ela", "ese", "ess", "ibu", "idi", "ili", "io ", "l e", "lid", "mo ", "mun", "nci", "ndo", "ns ", "nta", "nto", "o s", "omu", "ope", "rib", "rin", "s i", "s m", "sti", "ual", "ver", "vol", " ci", " hu", " os", " va", "a l", "a r", "alo", "ame", "ano", "ar ", "açõ", "bem", "bil", "bro", "cie", "cíp", "dam", "div", "e e", "e n", "ece", "eda", "egu", "emb", "end", "enç", "er ", "erv", "hum", "ica", "ied", "ime", "inc", "ind", "itu", "içõ", "lor", "m c", "m d", "ma ", "man", "mbr", "na ", "nac", "nai", "ncí", "nve", "nçã", "o r", "oci", "ome", "ont", "onv", "opa", "ore", "pa ", "pio", "pre", "pri", "r u", "ras", "ros", "s f", "seg", "soc", "sse", "sso", "ste", "tai", "tra", "tur", "uns", "val", "ven", "ça ", "ípi", " at", " en", " ev", " ho", " ma", " ne", " ob", " qu", " su", " te", "a i", "abe", "abi", "adi", "aes", "ani"}
+var pt = []string{"os ", "de ", " de", " e ", "que", "as ", " qu", "ão ", "em ", " co", " se", "ue ", " a ", "do ", "da ", " o ", "ra ", "es ", "to ", "ar ", "e d", " di", " pe", "a p", "s e", " pr", "a c", "te ", "nte", "ou ", "e a", "ito", "s d", " do", " pa", " es", " me", "ia ", "o e", " ca", " ou", " po", " à ", "res", "e e", "e q", "o d", " em", " um", "a e", "com", "e c", "ent", "er ", "o a", "o p", "rei", "tra", "ção", " da", " os", " re", " to", "con", "dos", "mos", " na", " no", "a t", "ant", "eit", "m d", "um ", "uma", " as", " li", " mu", " te", "a a", "a d", "al ", "ara", "dir", "e o", "ire", "o c", "o o", "r a", "ro ", "tod", "ua ", " ma", " ve", "ade", "ado", "e p", "eir", "ma ", "na ", "s a", "s o", "s p", "se ", "ser", "a s", "e m", "ho ", "is ", "no ", "por", "ver", " le", "dad", "est", "inh", "m a", "men", "nci", "pro", "va ", " al", " tr", "a l", "a n", "che", "cia", "e r", "ei ", "erd", "ess", "nha", "o s", "o t", "par", "pre", "r o", "ria", "s c", "sso", "tem", " ba", " ch", " in", " nã", " su", " ta", "a o", "a r", "aba", "alh", "am ", "ava", "açã", "car", "ela", "ere", "esc", "ha ", "io ", "ião", "lho", "man", "nos", "ntr", "não", "o m", "o q", "om ", "ont", "qua", "sta", "tos", "uit", " fa", " ir", "a m", "ada", "ais", "amo", "anh", "ass", "e f", "e s", "e v", "eu ", "gua", "ida", "ido", "ir ", "ira", "la ", "m p", "me ", "min", "mui", "nde", "nho", "nto", "odo", "ond", "per", "pes", "ran", "ras", "rde", "scr", "soa", "ual", "ura", "ões", " an", " fi", " so", "ame", "ano", "ata", "ber", "cas", "cio", "co ", "e l", "ibe", "igu", "ina", "iro", "ist", "lha", "m o", "m s", "o i", "oa ", "oda", "ois", "or ", "pas", "pel", "pri", "rab", "rda", "s m", "s n", "s s", "sem", "sua", "uei", "ém ", "ênc", " ar", " at", " en", " fo", " há", " ig", " nu", " on", " vi"}
 
 var pl = []string{"ch ", " i ", " pr", "ych", "nie", "ści", " po", "ośc", "prz", "ci ", "ego", "go ", "ie ", "pra", " na", "nyc", "raw", "rze", "eni", "noś", " or", " un", "art", "dzi", "h p", "ora", "stw", "uni", "wie", " eu", " ko", " za", "az ", "eur", "h w", "ia ", "ien", "kie", "owa", "owi", "raz", "rop", "rzy", "ski", "uro", "wa ", "ym ", " ka", " sp", " ty", " w ", "czn", "ej ", "ejs", "i o", "iej", "lno", "lny", "neg", "nia", "rod", "sta", "zło", "ńst", " cz", " wo", " ws", "a p", "aln", "aw ", "cji", "czł", "ecz", "i i", "i p", "ji ", "kar", "kon", "na ", "orz", "twa", "w c", "wsp", "óln", " pa", " ro", " sw", " tr", " wy", " z ", "a s", "ach", "ada", "ady", "ani", "aro", "ańs", "dow", "dy ", "ez ", "i s", "i z", "ich", "icz", "ied", "ier", "ii ", "jsk", "kow", "nar", "nii", "obo", "oln", "ope", "ows", "owy", "ość", "pań", "pej", "pod", "pól", "rów", "spo", "spó", "tyc", "tym", "war", "wol", "wyc", "y p", "za ", "zez", "zie", "zy ", "ść ", " dz", " je", " ni", " ob", " oc", " op", " os", " ró", " so", " st", " to", " wa", "a z", "asa", "awi", "ału", "ań ", "chr", "ctw", "cze", "dno", "e o", "e p", "e z", "em ", "ent", "est", "hro", "ict", "iąz", "ię ", "ju ", "m k", "ne ", "nic", "nko", "now", "nwe", "ny ", "nym", "o i", "o o", "och", "odn", "oju", "onk", "onw", "ony", "opr", "opy", "ozw", "ołe", "pie", "pow", "poł", "pre", "py ", "rad", "ron", "roz", "rto", "sad", "się", "sob", "sza", "taw", "toś", "two", "um ", "wan", "wen", "wia", "wią", "wni", "wob", "woj", "wsk", "yni", "ysz", "z t", "z z", "zas", "zki", "zny", "zwo", "zyn", "zys", "ówn", "ą p", "łec", "łon", "łu ", " ce", " do", " ic", " in", " kt", " lu", " mi", " od", " re", " si", " są", " us", " zw", "a i", "a j", "a k", "a n", "a u", "a w", "acj", "ano", "ate", "awa", "awo", "bli", "bod", "bow", "bun", "bą "}
 
@@ -31,3 +31,51 @@ var srCyr = []string{"је ", " у ", " и ", " је", " ко", " на", " се"
 var tl = []string{"ng ", "ang", " na", " an", "ay ", " sa", " ng", "an ", "sa ", " ma", "na ", " pa", " ka", "at ", "g m", " ay", "ala", "g p", "n n", " at", "g t", "ing", " ba", "pag", "apa", "ga ", "isa", "hin", "in ", "ong", "san", " ta", "a n", "a a", " mg", "man", "mga", "ata", "nga", "ama", "g i", "lan", " ni", " is", "aka", "awa", "a p", " hi", " si", "g n", "a s", "g k", "ina", "a m", "di ", "n a", "yan", "asa", " tu", "aba", "aga", "g a", "pan", "a b", "aki", "ila", "t n", " da", "a k", "aha", "ara", "g b", "g d", "ind", " la", "ali", "aya", "ndi", "tan", "abi", "aw ", "g s", "iya", "nag", "ta ", "y n", "ya ", "ag ", "al ", "gan", "iny", "nin", "o a", "yo ", " di", "ili", "lin", "mat", "ni ", "nyo", "on ", "po ", "gaw", "mag", "nan", "pin", "uma", "wa ", "y a", " pi", " po", "a t", "ban", "gka", "i m", "ito", "kin", "l a", "n s", "o s", "ung", "agp", "ana", "and", "dal", "ini", "nak", "nap", "no ", "o n", "sal", "to ", "wan", " ak", " y ", "ail", "ati", "bab", "g h", "i n", "it ", "ita", "kab", "kan", "si ", "t s", " bu", " in", " mi", "agk", "ain", "ani", "aon", "bat", "g l", "gal", "i s", "kal", "kas", "kit", "ko ", "lak", "mal", "may", "nda", "ngg", "pat", "rin", "tag", "tin", "ula", "yon", " ko", " pu", "as ", "hai", "il ", "ipi", "kay", "kha", "lal", "law", "mik", "nat", "ot ", "pap", "siy", "tul", " ha", " it", " t ", " wa", " ya", "a h", "ad ", "ags", "ari", "bil", "eri", "gpa", "ihi", "ikh", "ka ", "kap", "l n", "la ", "o y", "pam", "pil", "t a", "utu", "wal", "y i", " ga", " hu", " ju", " se", " ti", "a i", "aan", "ahi", "ano", "any", "api", "ayo", "bak", "d n", "g u", "gay", "gin", "iki", "lab", "lam", "mar", "nas", "os ", "pak", "ral", "raw", "sti", "tat", "ter", "w a", " du", " ku", " no", "ako", "alu", "atu", "ba ", "bal", "bis", "cia", "el ", "eme", "g g"}
 
 var nl = []string{"en ", " de", "de ", "er ", "et ", "an ", " he", "den", "een", " en", "n d", "aar", " ee", "te ", " ge", "gen", " wa", " va", " te", "ar ", "het", "ver", "van", "ij ", "nde", " we", " zi", " in", " da", "in ", "oor", " vo", "sch", "n e", "der", "ten", " me", "n h", " ve", " on", "cht", " be", "at ", "n v", " di", "n w", "ing", " op", "eer", "aan", "ijn", "zij", "ren", "n o", "ken", "el ", "ng ", "die", "ond", "op ", " al", " ha", "jn ", "or ", "ter", "ie ", "ste", " zo", "e v", "ijk", "nge", "lij", "n z", " ze", " na", "dat", "n t", "ere", "men", " hi", "n g", "is ", "ze ", "and", "voo", "ers", " st", "nd ", " aa", "r d", " ma", " to", " er", "rde", "iet", "len", "t d", " do", "e b", "n b", "nie", " wi", "end", "ns ", "ach", "met", "hij", "t h", " ho", "ls ", "al ", "erd", "n s", " is", "ich", "lle", " ni", " mo", "as ", "ele", "n m", "n a", "e h", "eli", "uit", "maa", "als", "e d", "e o", "waa", "ige", "t e", "wij", "che", "ik ", "om ", " om", "n k", "t v", "e s", "ig ", "was", "e w", "ven", " la", "n i", "gel", "eel", "e m", "ht ", "ove", "we ", "wee", "st ", "est", "ege", " bi", "ge ", "ch ", "ier", " ko", "e k", "e z", "eid", "nen", "it ", "n n", "ang", "naa", "ede", "ord", "r h", "doo", "e g", "bij", " ik", "hee", "e e", "lan", "oet", "jk ", "eve", "ien", "haa", " wo", "ens", "r e", "moe", "rij", " oo", " sp", " ui", "of ", " gr", "ons", "t o", "zoo", "hte", "t z", "t w", "pen", "t g", "eld", "sta", " no", "s e", "aat", "kke", " bo", " ka", "toe", "nne", " ov", " mi", "r o", "zic", "es ", "mee", "e l", "oot", "voe", "t i", "n l", "oen", " li", "all", "had", "ts ", "ete", " of", " sc", "r v", "laa", "rs ", "roo", "ind", "ad ", "are", "e t", "s d", "og ", "r w", "dan", "e p", "tig", "n p", "erk", "ome", "ot ", " re", "s v", "wor", " za", "gro", "nt ", "e a"}
+
+var cs = []string{" pr", " a ", "ní ", " ne", "prá", "ráv", "ost", " sv", " po", "na ", "ch ", "ho ", " na", "nos", "o n", " ro", "ání", "ti ", "vo ", "neb", "ávo", "má ", "bo ", "ebo", " má", "kaž", " ka", "ou ", "ažd", " za", " je", "dý ", "svo", "ždý", " př", "a s", " st", "sti", "á p", " v ", "obo", "vob", " sp", "bod", " zá", "ých", "pro", "rod", "ván", "ení", "né ", "ý m", "ého", " by", " ná", "spo", "ně ", "o p", "mi ", "í a", "ter", "roz", "ová", "to ", " ja", " li", "áro", "nár", "by ", "jak", "a p", "a z", "ny ", " vš", "kte", "i a", "lid", "ím ", "o v", "í p", "u p", "mu ", "at ", " vy", "odn", " so", " ma", "a v", " kt", "í n", "zák", "li ", "oli", "ví ", "kla", "tní", "pod", "stá", "en ", "do ", "t s", "mí ", "je ", "em ", "áva", " do", "byl", " se", "být", "í s", "rov", " k ", "čin", " ve", "ýt ", "í b", "it ", "dní", "vše", "pol", "o s", " bý", "tví", "nýc", "stn", "nou", "ejn", "sou", "ran", "ci ", "vol", "se ", "nes", "a n", "pří", "eho", "ným", "tát", "va ", "ním", "mez", "ají", "i s", "stv", "ké ", "ích", "ečn", "žen", "e s", "vé ", "ova", "své", "ým ", "kol", "du ", "u s", "jeh", "kon", "ave", "ech", "eré", "nu ", " ze", "i v", "o d", "í v", "hra", "ids", "m p", "ému", "ole", "y s", " i ", "maj", "o z", " to", "aby", "sta", " ab", "m a", "pra", " ta", "chn", " ni", "že ", "ovn", "ako", "néh", "len", "dsk", "rac", "lad", "chr", " že", "vat", " os", "sob", "aké", "i p", "smí", "esm", "st ", "i n", "m n", "a m", "lně", "lní", "při", "bez", "dy ", "áln", "ens", "zem", "t v", "čen", "leč", "kdo", "ými", " ji", "oci", "i k", " s ", "í m", "jí ", " či", "áv ", "ste", "och", " oc", "vou", "ákl", " vz", "rav", "odu", "nez", "inn", "ský", "nit", "ivo", "a j", "u k", "iál", " me", "ezi", "ské", "ven", "stu", "u a", "tej", "oln", "slu", "zen", "í z", "y b", "oko", "zac"}
+
+var sk = []string{" pr", " a ", " po", "prá", "na ", "ráv", "om ", " na", "ho ", "ebo", " ne", "vo ", " al", "ale", "bo ", "leb", " ro", "ia ", "o n", " do", " sa", "a s", "sa ", "ávo", " ka", "o s", "a p", "e s", "ova", " ma", "ch ", " je", " má", " ni", " v ", " za", "iť ", "má ", "pre", " so", " sv", "a v", "a z", "ať ", "u p", " st", " ve", "ani", "ažd", "dý ", "kaž", "me ", "o p", "o v", "som", "to ", "á p", "áva", "ého", "ý m", "ždý", " ak", " ob", " zá", "ie ", "je ", "mi ", "o a", "rod", "svo", "voj", "al ", "de ", "eni", "kto", "li ", "ne ", "ost", "sta", "sť ", "ti ", "van", "že ", " ná", "a n", "ci ", "do ", "du ", "est", "i a", "mu ", "nia", "nos", "nu ", "obo", "va ", " kt", " o ", " že", "e a", "ej ", "kon", "koľ", "ky ", "nem", "oho", "str", "tor", " sl", " vš", "a a", "bod", "bud", "by ", "ek ", "lob", "m s", "nie", "no ", "ní ", "odn", "odu", "ran", "roz", "slo", "sti", "u a", "vek", "zák", " sm", " sp", "a b", "aj ", "ali", "eho", "nik", "né ", "néh", "oľv", "ože", "pol", "rej", "rác", "sme", "vať", "ému", "ľve", "ť a", "žen", " bo", " mu", " sú", " te", " tr", " vy", "a k", "a o", "a r", "aké", "e p", "emo", "hra", "i p", "iko", "iu ", "maj", "ny ", "och", "odi", "pod", "pri", "pro", "stn", "tre", "ude", "ved", "vše", "y s", "áci", "šet", "ť s", " aj", " be", " bu", " in", " kr", " me", " s ", " to", " či", "a d", "ajú", "bez", "bol", "bož", "ce ", "dne", "dom", "dov", "e n", "e v", "em ", "eme", "ens", "etk", "eto", "h a", "i s", "iek", "il ", "jú ", "ko ", "koh", "l s", "mal", "mož", "nes", "náb", "o d", "o t", "oje", "oko", "osť", "ou ", "ov ", "ožn", "prí", "rom", "rov", "stv", "te ", "tra", "tro", "tát", "u s", "u z", "ábo", "áko", "ím ", "ú o", "ých", "ým ", "štá", "žno", " ab", " by", " ch", " de", " ho", " ko", " le", " oc", " si", " vi", " št", "a m"}
+
+var ro = []string{" de", "și ", " și", "re ", " în", "are", "te ", "de ", "ea ", "ul ", "rep", "le ", "ept", "dre", "e d", " dr", "ie ", "în ", "e a", "ate", "ptu", " sa", "tul", " pr", "or ", "e p", " pe", "la ", "e s", "ori", " la", " co", "lor", " or", "ii ", "rea", "ce ", "au ", "tat", "ați", " a ", " ca", "ent", " fi", "ale", "ă a", "a s", " ar", "ers", "per", "ice", " li", "uri", "a d", "al ", " re", "e c", "ric", "nă ", "i s", "e o", "ei ", "tur", " să", "lib", "con", "men", "ibe", "ber", "rso", "să ", "tăț", "sau", " ac", "ilo", "pri", "ăți", "i a", "i l", "car", "l l", "ter", " in", "ție", "că ", "soa", "oan", "ții", "lă ", "tea", "ri ", "a p", " al", "ril", "e ș", "ană", "in ", "nal", "pre", "i î", "uni", "ui ", "se ", "e f", "ere", "i d", "e î", "ita", " un", "ert", "ile", "tă ", "a o", " se", "i ș", "pen", "ia ", "ele", "fie", "i c", "a l", "ace", "nte", "ntr", "eni", " că", "ală", " ni", "ire", "ă d", "pro", "est", "a c", " cu", " nu", "n c", "lui", "eri", "ona", " as", "sal", "ând", "naț", "ecu", "i p", "rin", "inț", " su", "ră ", "e n", " om", "ici", "nu ", "i n", "oat", "ări", "l d", " to", "tor", " di", " na", "iun", " po", "oci", "tre", "ni ", "ste", "soc", "ega", "i o", "gal", " so", " tr", "ă p", "a a", "n m", "sta", "va ", "ă î", "fi ", "res", "rec", "ulu", "nic", "din", "sa ", "cla", "nd ", " mo", " ce", " au", "ara", "lit", "int", "i e", "ces", "uie", "at ", "rar", "rel", "iei", "ons", "e e", "leg", "nit", "ă f", " îm", "a î", "act", "e l", "ru ", "u d", "nta", "a f", "ial", "ra ", "ă c", " eg", "ță ", " fa", "i f", "rtă", "tru", "tar", "ți ", "ă ș", "ion", "ntu", "dep", "ame", "i i", "reb", "ect", "ali", "l c", "eme", "nde", "n a", "ite", "ebu", "bui", "ât ", "ili", "toa", "dec", " o ", "pli", "văț", "nt ", "e r", "u c", "ța ", "t î", "l ș", "cu ", "rta"}
+
+var ca = []string{" de", "la ", "es ", " la", " a ", " i ", "de ", "a l", " se", " pe", "a p", " qu", "at ", "na ", "per", " co", " el", "ió ", "que", "el ", "ls ", "tat", " un", "en ", "ent", "ret", "dre", "er ", "nt ", "ona", " pr", "ar ", "va ", " dr", " to", "al ", "men", " ll", "ció", "et ", "ns ", "sev", "tot", " ca", " en", " re", "a t", "ia ", "ra ", "ue ", " es", " o ", "a s", "aci", "s d", "s i", "ts ", " al", " no", " pa", "da ", "e l", "els", "s a", " l ", "a a", "eva", "res", " le", "a d", "con", "del", "est", "les", "pro", "t a", "ta ", " ha", "lle", "no ", " in", " so", " té", "a e", "em ", "i d", "ion", "r a", "t d", "té ", "ual", "una", " d ", " di", "e c", "ers", "ons", "re ", "t i", "un ", "é d", " ni", "a c", "a m", "als", "ame", "cio", "r l", "s e", " am", "a f", "o s", "ser", " an", " fo", " te", "a n", "ada", "i a", "lli", "nal", "ota", "qua", "rso", "son", " na", " po", "amb", "ana", "cia", "ens", "ing", "l a", "nci", "om ", "par", "rta", "s o", "tra", " ar", " ta", " ve", "arr", "e d", "e e", "i e", "ici", "ita", "mb ", "nac", "ol ", "rà ", "se ", "sta", "us ", "vol", " fa", " he", " me", " mo", "a i", "ara", "ca ", "col", "com", "e r", "eix", "esc", "ha ", "ica", "igu", "ina", "it ", "l c", "l d", "n d", "nar", "ntr", "pre", "s p", "tes", "ues", "és ", " ac", " aq", " ho", " ma", "a r", "an ", "ant", "aqu", "ava", "avi", "cci", "dic", "e n", "e p", "ell", "eta", "evo", "for", "iu ", "l p", "lib", "lic", "lse", "n a", "n p", "ndi", "nse", "olt", "ont", "ort", "ran", "rem", "s c", "s q", "sa ", "tal", "tar", "ter", "ura", "ure", "via", " fe", " fi", " ju", " tr", "ber", "bre", "cap", "car", "cla", "e i", "ei ", "eli", "ert", "esp", "eu ", "fon", "gua", "gú ", "he ", "hom", "i h", "i l", "ibe", "ic ", "ind", "ins", "ir ", "l e", "lei", "lig", "lit", "man"}
+
+var gl = []string{"que", "as ", "os ", " qu", " e ", "ue ", "de ", " de", " co", "do ", " ca", " po", " pa", " no", "da ", " me", " a ", " do", " o ", " se", "ar ", "ra ", "a c", "e c", "s d", "mos", "s a", " un", " ve", "e d", "es ", "o p", "on ", "s e", "e a", "e p", "a e", "a p", "eir", "la ", "na ", "te ", "a a", "a d", "a m", "a n", "me ", "par", "se ", " as", " da", " es", " na", " pe", " pr", "con", "e q", "nte", "o c", "ro ", "s c", " mo", "llo", "nha", "o d", "o e", "ta ", "unh", " al", "a t", "ada", "amo", "ara", "e e", "en ", "er ", "est", "go ", "ha ", "no ", "o a", "por", "un ", " ir", " te", " á ", "a q", "aba", "ai ", "cas", "e m", "e o", "e s", "ici", "ira", "moi", "non", "o q", "oit", "pol", "s p", "ía ", " an", " fa", " ma", "all", "an ", "ant", "co ", "e n", "e t", "ell", "ere", "iro", "ión", "mes", "n a", "n c", "ola", "pre", "ver", " ce", " ch", " os", " re", " ta", "car", "che", "com", "ei ", "ent", "igo", "ir ", "is ", "iña", "lo ", "ns ", "nta", "ome", "ond", "ont", "ou ", "r a", "ras", "s v", "ña ", " ao", " ba", " ha", " le", "a o", "a r", "ao ", "bal", "bra", "e f", "e v", "e x", "ece", "emp", "eu ", "lla", "ndo", "nos", "nun", "o m", "or ", "per", "r o", "ran", "rem", "s n", "sta", "tar", "tas", "tra", " av", " di", " fi", " fo", " nu", " on", " sa", " ti", " tr", " xa", "alg", "amb", "and", "ano", "art", "asa", "ase", "ata", "cam", "can", "ció", "des", "emo", "hai", "ia ", "io ", "ita", "meu", "n d", "n t", "nad", "nde", "nse", "o l", "o n", "o o", "o t", "orq", "pas", "pro", "rab", "re ", "res", "rqu", "s f", "s m", "tos", "uei", "ón ", "úa ", " en", " ho", " lo", " mi", " va", " xe", " xu", " é ", "a l", "a x", "ade", "al ", "ald", "ame", "ami", "anc", "ans", "ard", "arr", "bam", "ban", "bar", "cad", "cio", "dar", "dos", "e l", "eix", "ero", "fic"}
+
+var sl = []string{" pr", "in ", " in", "rav", "pra", "do ", "anj", "ti ", "avi", "je ", "nje", "no ", "vic", " do", "ih ", " po", "li ", "o d", " za", " vs", "ost", "a p", "ega", "o i", "ne ", " dr", " na", " v ", "ga ", " sv", "ja ", "van", "svo", "ako", "pri", "co ", "ico", "i s", "e s", "o p", " ka", "ali", "stv", "sti", "vsa", " ne", " im", "sak", "ima", "jo ", "dru", "nos", "kdo", "i d", "akd", "i p", "nja", "o s", "nih", " al", "o v", "ma ", "i i", " de", "e n", "pre", "vo ", "i v", "ni ", "red", "obo", "vob", "avn", "neg", " bi", "ova", " iz", "ove", "iti", "lov", "ki ", "jan", "a v", "na ", " so", "em ", " nj", "a i", "se ", " te", "tva", "oli", "bod", "ruž", "e i", " ra", " sk", "ati", "e p", "aro", "i k", " ob", "a d", " čl", "eva", "rža", "drž", " sp", "ko ", "i n", " se", " ki", "ena", "sto", "e v", "žen", "nak", "kak", "i z", "var", "ter", "žav", " mo", "di ", "gov", "imi", "va ", "kol", "n s", " z ", "mi ", "ovo", "rod", "voj", " en", "nar", "ve ", " je", "pos", "a s", "ego", "vlj", "jeg", " st", "h p", "er ", "kat", "člo", "ate", "a z", "enj", "n p", "del", "i o", "lja", "pol", "čin", "a n", "ed ", "sme", "jen", "eni", " ta", "odn", " ve", " ni", "e b", "en ", " me", "jem", "kon", "nan", "elj", "sam", "da ", "lje", "zak", "ovi", "šči", "raz", "ans", "ju ", "bit", "ic ", " sm", "ji ", "nsk", "v s", " s ", "n v", "tvo", "ene", "a k", "me ", "vat", "ora", "krš", "nim", "sta", "živ", "ebn", "ev ", "ri ", "eko", "o k", "n n", "so ", "za ", "ičn", "ski", "e d", " va", "o z", "aci", "cij", "eja", "elo", "dej", "si ", "nju", "vol", "kih", "i m", "nst", "kup", "kov", "uži", "la ", "mor", "vih", " da", "h i", "lju", "otr", "med", "o a", "sku", "rug", "odo", "ijo", "dst", "spo", "tak", "zna", "edn", "vne", "ara", "ršn", "itv", "odi", "u s", "čen", "boš", "nik", "avl", "akr"}
+
+var hr = []string{" pr", " i ", "je ", "rav", "pra", "ma ", " na", "ima", " sv", "na ", "ti ", "a p", "nje", " po", "a s", "anj", "a i", "vo ", "ko ", "da ", "vat", "va ", "no ", " za", "i s", "o i", "ja ", "avo", " u ", " im", "sva", "i p", " bi", "e s", "ju ", "tko", "o n", "li ", "ili", "van", "ava", " sl", "ih ", "ne ", "ost", " dr", "ije", " ne", "jed", "slo", " ra", "u s", "lob", "obo", " os", "bod", " da", " ko", "ova", "nja", "koj", "i d", "atk", "iti", " il", "stv", "pri", "om ", "im ", " je", " ob", " su", " ka", "i i", "i n", "e i", "vje", "i u", "se ", "dru", "bit", "voj", "ati", "i o", "ćen", "a o", "o p", "a b", "a n", "ući", " se", "enj", "sti", "a u", "edn", "dje", "lo ", "ćav", " mo", "raz", "u p", " od", "ran", "ni ", "rod", "a k", "su ", "aro", "drć", "svo", "ako", "u i", "rća", "a j", "mij", "ji ", "nih", "eni", "e n", "e o", " nj", "pre", "pos", "ćiv", "oje", "eno", "e p", "nar", "oda", "nim", "ovo", "aju", "ra ", "ći ", "og ", "nov", "iva", "a d", "nos", "bra", "bil", "i b", "avn", "a z", "jen", "e d", "ve ", "ora", "tva", "jel", "sta", "mor", "u o", "cij", "pro", "ovi", "za ", "jer", "ka ", "sno", "ilo", "jem", "red", "em ", "lju", "osn", "oji", " iz", "aci", " do", "lje", "i m", " ni", "odn", "nom", "jeg", " dj", "vno", "vim", "elj", "u z", "o d", "rad", "o o", "m i", "du ", "uje", " sa", "nit", "e b", " st", "oj ", "tit", "a ć", "dno", "e u", "o s", "u d", "eću", "ani", "dna", "nak", "nst", "stu", " sm", "e k", "u u", "an ", "gov", "nju", "juć", "aln", "m s", "tu ", "a r", "ćov", "jan", "u n", "o k", "ist", "ću ", "te ", "tvo", "ans", "šti", "nu ", "ara", "nap", "m p", "nić", "olj", "bud", " bu", "edi", "ovj", "i v", "pod", "sam", "obr", "tel", " mi", "ina", "zaš", "e m", "ašt", " vj", "ona", "nji", "jek", " ta", "duć", "ija", " ćo", "tup", "h p", "oja"}
+
+var id = []string{"an ", "ang", "ng ", "ya ", " di", " se", " be", " da", " sa", "dan", "kan", "ak ", "nya", "ata", " ka", " ke", " me", "ber", "aka", "aya", "di ", "per", "ran", " at", " pe", "ah ", "nga", " ha", "ama", "say", " ma", " te", "at ", "au ", "eng", "ala", "ma ", "men", " ba", "n d", "un ", "gan", "k a", "lam", "n b", "ora", "tan", " ya", "a b", "dak", "i m", "n s", " in", " su", "ara", "as ", "hak", "kam", "sam", "tau", "uda", "yan", " ta", "a d", "a k", "a t", "ema", "ena", "i s", "pun", "ung", " ak", " de", " ti", "ai ", "asi", "man", "n m", "ni ", "ntu", "pa ", "ter", " or", "aan", "am ", "ami", "ant", "apa", "asa", "eba", "ent", "erh", "erl", "ida", "ini", "rha", "tas", "tid", "u d", " la", " pa", " pu", "a a", "a h", "a p", "a s", "aga", "ap ", "aru", "dah", "eka", "g b", "i a", "i d", "i k", "ih ", "in ", "ina", "ke ", "n a", "n k", "na ", "san", "sem", "tu ", " ja", " un", "aik", "al ", "alu", "ari", "ban", "beb", "dip", "ebe", "ek ", "ela", "eri", "g d", "har", "k b", "k s", "kal", "kar", "keb", "mem", "mi ", "n i", "n p", "nan", "nda", "nta", "ren", "rla", "sa ", "si ", "sud", "tah", "ti ", "tuk", "u k", "ua ", "uk ", "uka", "um ", " bi", " bu", "a m", "amu", "ana", "any", "bah", "bas", "ben", "car", "den", "dis", "dun", "ers", "g p", "gat", "gi ", "h s", "i r", "iny", "ipe", "ita", "k d", "kat", "lai", "lan", "mas", "mba", "min", "mu ", "n h", "n y", "nas", "ndi", "pan", "pat", "ra ", "ri ", "rin", "sih", "ta ", "uku", "us ", " ag", " ap", " bo", " hu", " ko", " mu", " na", " wa", "a j", "ada", "adi", "ahu", "ain", "aku", "amb", "ann", "ar ", "atu", "bai", "bis", "bol", "dap", "emp", "emu", "end", "erb", "erc", "erg", "erj", "eti", "g s", "g t", "gam", "h d", "h m", "i t", "ian", "iap", "ik ", "ind", "ing", "isk", "k m", "ka ", "lah", "lal", "lu ", "mak"}
+
+var ms = []string{"an ", "ang", "ng ", " se", " pe", " be", " di", "dan", " ke", " da", "ya ", " sa", "ah ", "ak ", "ran", "ber", "ada", "kan", "per", "ata", "aya", " me", "ala", "di ", "ama", " ka", "ara", "nga", "au ", "say", " at", " ba", "a s", "aka", "da ", "ma ", " ma", " te", "a b", "i s", "nda", " pa", "a p", "at ", "lah", "n b", "n d", "nya", " ha", "aan", "asa", "awa", "gan", "n a", "pa ", "tau", " la", " su", "eba", "erh", "men", "tan", "yan", " ya", "ana", "apa", "end", "eng", "lam", "pad", "rha", "ta ", " ta", " ti", "a a", "a m", "as ", "dak", "era", "hak", "n p", "ora", "sam", "ti ", "tu ", "a d", "ar ", "bas", "ema", "gi ", "har", "i k", "ker", "man", "sem", "sia", " ad", " ak", " or", " un", "a t", "ap ", "asi", "ban", "ent", "epa", "eri", "erl", "ers", "g d", "i b", "ini", "kam", "mba", "n s", "ri ", "san", "ter", "tia", "u d", "ung", "wak", " bo", " in", "aga", "al ", "am ", "ami", "ant", "beb", "bol", "dal", "eh ", "ela", "emu", "ena", "h b", "i a", "i d", "i p", "iap", "ita", "kep", "leh", "mas", "n k", "ni ", "nta", "ntu", "ole", "pem", "pun", "tuk", "u s", "uk ", "uka", "un ", " aw", " wa", "ahu", "ai ", "ain", "h d", "i m", "ih ", "in ", "ina", "k d", "k k", "ke ", "lai", "mi ", "min", "n i", "na ", "nan", "ngg", "pen", "ra ", "rat", "sa ", "seb", "u m", "uda", " ag", " pu", "a k", "aha", "amb", "ari", "atu", "bah", "ben", "car", "dap", "ebe", "emb", "g a", "g b", "h k", "ham", "hen", "i t", "ian", "ida", "iki", "ira", "k a", "k m", "keb", "lu ", "mak", "mal", "mem", "n h", "n l", "n t", "n y", "nas", "nti", "pi ", "sel", "si ", "sih", "tah", "ten", "tid", "ua ", "unt", " ap", " de", " he", " hu", " it", " ne", " ra", "a l", "adi", "agi", "akt", "amp", "ann", "any", "bar", "dah", "dar", "den", "dir", "dis", "eca", "ek ", "ele", "erj", "esi", "eta", "eti"}
+
+var sw = []string{"wa ", "na ", "a k", "a m", "ni ", " ya", " na", " ku", "ya ", " wa", "a n", "ili", " ni", " ha", " kw", "kwa", " ki", "a h", "ali", "ana", "i n", "a s", "li ", "ma ", " ka", " ma", " sa", "aki", "i y", "kuw", "lik", "a b", "hak", "ka ", "ki ", "la ", "uwa", " tu", "a u", "aka", "ang", "ini", "ish", "a w", "au ", "azi", "ia ", "te ", "u a", " au", "a y", "ani", "da ", "ha ", "mba", "sha", "tak", "u k", " ba", " bi", " mt", "a a", "ama", "gu ", "iku", "ila", "ita", "ngu", "oni", "ote", "ri ", "uli", "ata", "bu ", "i k", "ika", "iki", "iwa", "nil", "tu ", "u n", "wen", " hi", " za", "aba", "abu", "amb", "cha", "end", "hi ", "kwe", "mtu", "mu ", "nda", "uhu", "uta", "zi ", " an", " mi", " sh", "a t", "aha", "ara", "bab", "ele", "hur", "i a", "ina", "kam", "ngi", "u w", "uru", "yo ", "za ", " ch", " ji", " uh", "ada", "aku", "asa", "ati", "awa", "dha", "eng", "eri", "i t", "i w", "kat", "ke ", "kil", "kit", "kut", "mwa", "nge", "ria", "ru ", "she", "una", " hu", " la", " mk", " ny", " si", " wo", "a c", "adi", "ake", "ala", "ami", "ari", "ban", "cho", "ema", "fu ", "hal", "har", "her", "i m", "iji", "ima", "imu", "ing", "kul", "kun", "liw", "o n", "o y", "sab", "tum", "u m", "uu ", "wak", "wam", "wan", "wat", "wot", "zo ", " am", " as", " li", " mw", " pa", "a j", "a l", "aad", "ame", "amu", "aye", "ba ", "bad", "bil", "e w", "e y", "ea ", "gi ", "ham", "han", "i h", "i i", "i s", "i z", "ibi", "ii ", "ine", "ion", "iri", "ja ", "jin", "jio", "kaz", "kin", "kis", "kup", "le ", "lin", "lis", "man", "mbe", "moj", "nas", "o k", "oja", "ra ", "sa ", "tan", "ti ", "tul", "u y", "uba", "uri", "yak", "yan", "zan", "zim", " al", " at", " da", " di", " il", " mc", " mp", " ta", " vi", " we", "a d", "ach", "adh", "afi", "aji", "amo", "and", "aon", "asi", "asu", "ate", "atu"}
+
+var yo = []string{"ti ", " ní", "ọ́ ", " ẹ̀", "ní ", " lá", "ẹ́ ", "àn ", "kan", "an ", "tí ", " tí", "tọ́", " kò", "ọ̀ ", " àw", " àt", "ẹ̀ ", "ẹ̀t", "bí ", "àti", "lát", "áti", " gb", "lẹ̀", " ló", " ó ", "àwo", "gbo", "n l", " a ", " tó", "í è", "ra ", "n t", "ọ̀k", "sí ", "tó ", "kọ̀", "ìyà", " sí", "ílè", "orí", "ni ", "yàn", "dè ", "ì k", "èdè", " or", "ún ", "ríl", "í à", "jẹ́", " èd", "àbí", "ọ̀ò", "tàb", "nì ", "í ó", "n à", " tà", " ti", " wo", "nìy", "í ì", "ó n", " jé", " sì", "ló ", "kò ", "n è", "wọ́", " bá", "n n", "sì ", " fú", "í a", "rẹ̀", "fún", " pé", " òm", "gbà", " kí", " èn", "ènì", "in ", "òmì", "ìí ", "ba ", "nir", "pé ", "ira", "mìn", "ìni", "n o", "ràn", "ìgb", " ìg", "bá ", " rè", "kí ", "n e", "un ", "gba", "í ò", "nú ", "nín", "gbé", "yé ", " ka", "ínú", "a k", "fi ", " fi", "bẹ́", "dọ̀", "ó s", "i l", "wà ", "í i", "i ì", "hun", "bò ", "i ò", "dá ", "bo ", "áà ", "ó j", "lọ́", "àgb", "ohu", " oh", " bí", " ọ̀", "bà ", "ara", "yìí", "ogb", "írà", "n s", "ú ì", " ìb", "pọ̀", "í k", " lè", "bog", "i t", "à t", "óò ", "yóò", "kọ́", "gẹ́", "à l", "ọ́n", "rú ", "lè ", " yó", "a w", "ọ̀r", " wà", "ò l", "í t", "ó b", "i n", "ọ́w", "yí ", "í w", "ìké", "láà", "wùj", "àbò", "i è", "ùjo", "fin", "ẹ́n", "n k", "í e", "i j", "ú à", " ìk", "òfi", " òf", " ar", "i s", "mìí", "ìír", " mì", " ir", "rin", "náà", " ná", "jú ", " yì", "ó t", " i ", "fẹ́", "kàn", "rí ", "ú è", "à n", "wù ", "é à", " mú", " èt", "áyé", "í g", "ẹ̀d", "àwù", "ẹ̀k", " ìd", "irú", "í o", "i o", "i à", "láì", "í n", "ípa", " kú", "níp", " ìm", "a l", "kẹ́", "bé ", "i g", "de ", "ábé", "ìn ", "báy", "ígb", "wọ̀", "níg", "mú ", "láb", " àà", "n f", "ẹ̀s", "ùn ", "i a", "ayé", "èyí", " èy", "mọ́", "á è", " ni", "n b", " wó", " ìj", "gbá", "ọ̀n", "ọ́g"}
+
+var ha = []string{"da ", " da", "in ", "a k", "ya ", "an ", "a d", "a a", " ya", " ko", " wa", " a ", "sa ", "na ", " ha", "a s", "ta ", "kin", "wan", "wa ", " ta", " ba", "a y", "a h", "n d", "n a", "iya", "ko ", "a t", "ma ", "ar ", " na", "yan", "ba ", " sa", "asa", " za", " ma", "a w", "hak", "ata", " ka", "ama", "akk", "i d", "a m", " mu", "su ", "owa", "a z", "iki", "a b", "nci", " ƙa", " ci", " sh", "ai ", "kow", "anc", "nsa", "a ƙ", "a c", " su", "shi", "ka ", " ku", " ga", "ci ", "ne ", "ani", "e d", "uma", "cik", "kum", "uwa", "ana", " du", "ɗan", "ali", "i k", " yi", "ada", "ƙas", "aka", "kki", "utu", "n y", "a n", "hi ", " ra", "mut", " do", " ad", "tar", " ɗa", "nda", " ab", "man", "a g", "nan", "ars", "and", "cin", "ane", "i a", "yi ", "n k", "min", "sam", "ke ", "a i", "ins", "yin", "ki ", "nin", "aɗa", "ann", "ni ", "tum", "za ", "e m", "ami", "dam", "kan", "yar", "en ", "um ", "n h", "oka", "duk", "mi ", " ja", "ewa", "abi", "kam", "i y", "dai", "mat", "nna", "waɗ", "n s", "ash", "ga ", "kok", "oki", "re ", "am ", "ida", "sar", "awa", "mas", "abu", "uni", "n j", "una", "ra ", "i b", " ƙu", "dun", "cew", "a r", "aba", "ƙun", "ce ", "e s", "a ɗ", "san", "she", "ara", "li ", "kko", "ari", "n w", "m n", "buw", "aik", "u d", "kar", " ai", "niy", " ne", "hal", "rin", "bub", "zam", "omi", " la", "rsa", "ubu", "han", "are", "aya", "a l", "i m", "zai", "ban", "o n", "add", "n m", "i s", " fa", "bin", "r d", "ake", "uns", "sas", "tsa", "dom", " ce", "ans", " hu", "me ", "kiy", "ƙar", " am", "ɗin", " an", "ika", "jam", "i w", "wat", "n t", "yya", "ame", "n ƙ", "abb", "bay", "har", "din", "hen", "dok", "yak", "n b", "nce", "ray", "gan", "fa ", "on ", " ki", "aid", " ts", "rsu", " al", "aye", " id", "n r", "u k", "ili", "nsu", "bba", "aur", "kka", "ayu", "ant", "aci", "dan"}
+
+var zu = []string{"nge", "oku", "lo ", " ng", "a n", "ung", "nga", "le ", "lun", " no", "elo", "wa ", "la ", "e n", "ele", "ntu", "gel", "tu ", "we ", "ngo", " um", "e u", "thi", "uth", "ke ", "hi ", "lek", "ni ", "ezi", " ku", "ma ", "nom", "o n", "pha", "gok", "nke", "onk", "a u", "nel", "ulu", "oma", "o e", "o l", "kwe", "unt", "ang", "lul", "kul", " uk", "a k", "eni", "uku", "hla", " ne", " wo", "mun", " lo", "kel", "ama", "ath", "umu", "ho ", "ela", "lwa", "won", "zwe", "ban", "elw", "ule", "a i", " un", "ana", "une", "lok", "ing", "elu", "wen", "aka", "tho", "aba", " kw", "gan", "ko ", "ala", "enz", "o y", "khe", "akh", "thu", "u u", "na ", "enk", "kho", "a e", "zin", "gen", "i n", "kun", "alu", "mal", "lel", "e k", "nku", "e a", "eko", " na", "kat", "lan", "he ", "hak", " ez", "o a", "kwa", "o o", "ayo", "okw", "kut", "kub", "lwe", " em", "yo ", "nzi", "ane", "obu", " ok", "eth", "het", "ise", "so ", "ile", "nok", " ba", "ben", "eki", "nye", "ike", "i k", "isi", " is", "aph", "esi", "nhl", "mph", " ab", "fan", "e i", "isa", " ye", "nen", "ini", "ga ", "zi ", "fut", " fu", "uba", "ukh", "ka ", "ant", "uhl", "hol", "ba ", "and", "do ", "kuk", "abe", "za ", "nda", " ya", "e w", "kil", "the", " im", "eke", "a a", "olo", "sa ", "olu", "ith", "kuh", "o u", "ye ", "nis", " in", "ekh", "e e", " ak", "i w", "any", "khu", "eng", "eli", "yok", "ne ", "no ", "ume", "ndl", "iph", "amb", "emp", " ko", "i i", " le", "isw", "zo ", "a o", "emi", "uny", "mel", "eka", "mth", "uph", "ndo", "vik", " yo", "hlo", "alo", "kuf", "yen", "enh", "o w", "nay", "lin", "hul", "ezw", "ind", "eze", "ebe", "kan", "kuz", "phe", "kug", "nez", "ake", "nya", "wez", "wam", "seb", "ufa", "bo ", "din", "ahl", "azw", "fun", "yez", "und", "a l", "li ", "bus", "ale", "ula", "kuq", "ola", "izi", "ink", "i e", "da "}
+
+var so = []string{" ka", "ay ", "ka ", "an ", "uu ", "oo ", "da ", "yo ", "aha", " iy", "ada", "aan", "iyo", "a i", " wa", " in", "sha", " ah", " u ", "a a", " qo", "ama", " la", "hay", "ga ", "ma ", "aad", " dh", " xa", "ah ", "qof", "in ", " da", "a d", "aa ", "iya", "a s", "a w", " si", " oo", "isa", "yah", "eey", "xaq", "ku ", " le", "lee", " ku", "u l", "la ", "taa", " ma", "q u", "dha", "y i", "ta ", "aq ", "eya", "sta", "ast", "a k", "of ", "ha ", "u x", "kas", "wux", " wu", "doo", "sa ", "ara", "wax", "uxu", " am", "xuu", "inu", "nuu", "a x", "iis", "ala", "a q", "ro ", "maa", "o a", " qa", "nay", "o i", " sh", " aa", "kal", "loo", " lo", "le ", "a u", " xo", " xu", "o x", "f k", " ba", "ana", "o d", " uu", "iga", "a l", "yad", "dii", "yaa", "si ", "a m", "gu ", "ale", "u d", "ash", "ima", "adk", "do ", "aas", " ca", "o m", "lag", "san", "dka", "xor", "adi", "add", " so", "o k", " is", "lo ", " mi", "aqa", "na ", " fa", "soo", "baa", " he", "kar", "mid", "dad", "rka", "had", "iin", "a o", "aro", "ado", "aar", "u k", "qaa", " ha", "ad ", "nta", "o h", "har", "axa", "quu", " sa", "n k", " ay", "mad", "u s", " ga", "eed", "aga", "dda", "hii", "aal", "haa", "n l", "daa", "xuq", "o q", "o s", "uqu", "uuq", "aya", "i k", "hel", "id ", "n i", " ee", "nka", " ho", "ina", "waa", "dan", "nim", "elo", "agu", "ihi", "naa", "mar", "ark", "saa", "riy", "rri", "qda", "uqd", " bu", "ax ", "a h", "o w", "ya ", "ays", "gga", "ee ", "ank", " no", "n s", "oon", "u h", "n a", "ab ", "haq", "iri", "o l", " gu", "uur", "lka", "laa", "u a", "ida", "int", "lad", "aam", "ood", "ofk", "dhi", "dah", "orr", "eli", " xi", "ysa", "arc", "rci", "to ", "yih", "ool", "kii", "h q", "a f", " ug", "ayn", "asa", " ge", "sho", "n x", "siy", "ido", "a g", "gel", "ami", "hoo", "i a", "jee", "n q", "agg", "al "}
+
+var am = []string{" መብ", "ሰው ", "ት አ", "ብት ", "መብት", " ሰው", " አለ", " ወይ", "ወይም", "ይም ", "ነት ", "ንዱ ", "አለው", "ለው ", "ዳንዱ", "ያንዳ", "ንዳን", "እያን", "ዱ ሰ", "ት መ", " እን", " የመ", " እያ", "እንዲ", " ነጻ", " የተ", "ም በ", "ው የ", "ም የ", " የሚ", "ና በ", "ን የ", " የማ", " አይ", "ነጻነ", "ና የ", "ው በ", "ቶች ", "ሆነ ", "ት የ", " በሚ", " መን", "ው እ", "ትና ", "ኀብረ", "ትን ", "ውም ", "ንኛው", "እኩል", "ብቻ ", "ኛውም", "ንም ", " ለመ", " ያለ", "ም ሰ", "ማንኛ", "መብቶ", " አገ", "ት በ", "ራዊ ", " እኩ", " ለማ", "ለት ", "በት ", "ሆን ", "መንግ", " በተ", "ረት ", "ብቶች", "ጋብቻ", "ዎች ", "ህንነ", "ጻነት", "ም እ", "ወንጀ", " ልዩ", "ሰብ ", "ማንም", "ጠበቅ", "ኩል ", "ደህን", " ማን", "ነጻ ", "ግኘት", "ማግኘ", " የሆ", " ሁሉ", "ች በ", " በመ", "ሥራ ", " ደህ", "ፈጸም", "ል መ", "ተግባ", " ድር", "ት ወ", "ው ማ", "ፍርድ", "ርድ ", " በሆ", "ር ወ", "በትም", "ትም ", "ይነት", "ቸው ", "ብ የ", "ነትና", "ቱን ", "ሕግ ", "ንና ", " ሥራ", "የማግ", " መሠ", "ኘት ", " ጊዜ", "ጻነቶ", "ነቶች", "በር ", "በኀብ", "ዩነት", "ልዩነ", " በኀ", " ዓይ", "ዓይነ", "ችና ", "ግባር", "ባር ", " ደረ", "ነው ", " ነው", "ደረጃ", "ም መ", " ወን", "ይማኖ", "ማኀበ", "ሃይማ", " ኑሮ", "መሠረ", "ሁሉ ", "ነቱ ", "ሌሎች", "ንግሥ", "በቅ ", "የሆነ", " ይህ", "ንዲጠ", "ገር ", "ተባበ", "ትክክ", "ጸም ", "ር የ", "ዲጠበ", "ው ከ", "ሩት ", "ድርጅ", " ብቻ", "ና ለ", "ይገባ", "የመኖ", "ንነት", "ቤተሰ", "ርጅት", "ት ድ", " መሰ", "እንደ", " አላ", "ብሔራ", "ት ለ", "ሔራዊ", "ርት ", "ህርት", "ውን ", "የሚያ", "ል እ", "ሆኑ ", "ምህር", "ትምህ", "ለበት", "አለበ", " አስ", "ሎች ", "ች የ", " በሕ", "ብረ ", " ከሚ", "ን አ", "ት እ", "ን ወ", "ረግ ", "በሆነ", "የኀብ", " የኀ", "መሆን", " መሆ", "ን መ", " ውሳ", "ንጀል", "ፈላጊ", "ህም ", "ረታዊ", "ክለኛ", "ክክለ", "ታዊ ", "ጀል ", "ኑሮ ", "ዓዊ ", "ዜግነ", "ንዲሁ", "ዲሁም", " ማኀ", "ገሩ ", "ር በ", "ብዓዊ", "አገሩ", "ሁም ", "ና ነ", "ሰብዓ", "የተባ", "ጅት ", "ማኖት", "ር አ", "ንግስ", "ኖት ", "በሕግ", "መኖር", "ው ያ", "መጠበ", "ረጃ ", " በማ", "ነትን", "ብነት", "ገብነ", " ገብ", "መፈጸ", " ሁኔ", "ሁኔታ", "ን ለ", "ው ለ", " ተግ", " የአ", " ይገ", " በአ", "ችን ", " ትም", "ነቱን", " ቢሆ", "ቢሆን", "ጊዜ ", "ረ ሰ", "ት ጊ", "ሰቡ ", "ምበት", "ላቸው", "አላቸ", "በነጻ", " በነ"}
+
+var ti = []string{" መሰ", " ሰብ", "ሰብ ", " ኦለ", "ትን ", "ኦለዎ", "ናይ ", " ናይ", " ኦብ", "ለዎ ", "ሕድሕ", "ኦብ ", "ድሕድ", "ሕድ ", "መሰል", "ውን ", "ሰል ", "ድ ሰ", "ይ ም", "ል ኦ", "ካብ ", " ሕድ", " ወይ", "ወይ ", " መን", " ነፃ", "ን መ", "ዝኾነ", "ታት ", "ብ ዝ", "ነት ", "ን ነ", " ካብ", "መሰላ", "ነፃነ", " እዚ", "ብ መ", "ኦዊ ", "ታትን", "መንግ", "ዊ መ", " እን", "ብ ብ", "ንግስ", "ት ኦ", "ሰላት", "ን ም", "ኾነ ", "እዚ ", "ብኦዊ", "ሰብኦ", "ን ኦ", " ንክ", " ዝኾ", "ን ን", " ምር", "ኹን ", "ይኹን", " ይኹ", "ምርካ", "ርካብ", " ኦይ", " ሃገ", "ሕጊ ", "ራት ", "ሎም ", " ብሕ", "ነ ይ", " ከም", "ማዕሪ", "ይ ብ", " ንም", " ዝተ", "ርን ", "ን ብ", "ራዊ ", "ብ ሕ", "ላትን", "ብ ኦ", "ማሕበ", "ነታት", " ኦድ", "ዕሪ ", " ማዕ", "ስታት", "ግስታ", " ውን", "ት መ", "ን ዝ", "ታዊ ", " ማሕ", "ነትን", "ንጋገ", "ድንጋ", " ስለ", " ድን", "ስራሕ", "ኩሎም", "ሕበራ", "ኦት ", "ን ሰ", "ዓለም", "ፃነታ", " ብም", "ት ወ", "መሰሪ", " ስራ", "ፃነት", "ተሰብ", "ካልኦ", "ልኦት", "ን ሓ", "ዓት ", "ዋን ", "ቡራት", "ሕቡራ", " ሕቡ", "ብሕጊ", "ድብ ", "ውድብ", " ውድ", "ብን ", "ትምህ", "ነቱ ", "ዚ ድ", "ሃገራ", " ኩሎ", "ለዎም", "ምህር", "ም መ", " ብዝ", "ምኡ ", "ኡ ው", "እንት", " ዓለ", " ብዘ", "በራዊ", " ሓለ", "ሓለዋ", "ዎም ", "ቱ ን", "ት ብ", "ጋገ ", "ነፃ ", " ምዃ", "ን ዘ", " ገበ", " ትም", "ኸውን", "ራሕ ", " ዘይ", "ህርቲ", "ርቲ ", "ከምኡ", "ሃይማ", " ምስ", "እንተ", " ስር", "ስርዓ", "ርዓት", "ባት ", "ይማኖ", "ሰሪታ", "ን ና", " ክብ", "ልን ", " ብማ", "ገሩ ", " ህዝ", "ላት ", "ት ና", "ይ ኦ", "ዕሊ ", "ለዝኾ", "ስለዝ", "ሪተሰ", "ብሪተ", "ሕብሪ", " ሕብ", "ን ተ", "በን ", "ሃገሩ", "ገ እ", "ኻዊ ", " ሃይ", "እን ", "ሪጋገ", " ምሕ", "ን እ", "ለኻዊ", " ብሓ", " ብሃ", " ክኸ", "ክኸው", "ብ ዘ", "ዃኑ ", "ዊ ክ", "ምን ", "ሓደ ", "ምዃኑ", "ም ን", "ት እ", "ዊ ወ", "ታውን", "ብዘይ", " ሕጊ", "ት ን", " ልዕ", " ካል", "ን ካ", "ሰባት", "ን ስ", "ናን ", "ቤተሰ", "ሕን ", "ለምለ", "ት ስ", "ምለኻ", "ተደን", "ባል ", "ኦድላ", "እዋን", " እዋ", "ደቂ ", " ደቂ", " ሰባ", "ፃን ", "ነፃን", "ግስቲ", "ዚ ብ", "ስቲ ", " ቤተ", "ምጥሓ", " ክሳ", " ነዚ", "ን ክ", "ነቲ ", " ነቲ", "ነዚ ", " ምእ", "ብነፃ", " ምዕ", "ምዕባ", "ዕባለ", "ክሳብ", " ብነ", "ል እ", "ዚ መ", "ልዕሊ", "ክብሩ", "ብማዕ", "ሳብ ", "ህይወ", "ኦቶም", "ምስ ", "ንገገ"}
+
+var tr = []string{" ve", "ve ", " bi", "lar", "ir ", " ya", "bir", " ha", "de ", "en ", "in ", " ka", "arı", "ler", "da ", "yor", "ın ", " bu", "an ", "er ", "ya ", "ını", "ede", "nda", "ni ", "ınd", " ge", " ta", " ye", "ama", "bu ", "et ", "im ", "iye", "mek", "nı ", "yet", " he", " ol", " ço", "aki", "e b", "ek ", "eni", "ere", "eri", "her", "ki ", "le ", "n i", "nın", "or ", "ra ", " ak", " da", " de", " gi", " ko", " sa", " so", " öğ", "a b", "am ", "ar ", "ara", "ard", "den", "e d", "e h", "e k", "e y", "eme", "eya", "hak", "ik ", "ile", "iyo", "son", "un ", "ğın", " ar", " ba", " be", " iç", " iş", " kö", " ma", " se", "a g", "aba", "aca", "ann", "anı", "aya", "az ", "ağı", "bil", "cağ", "dak", "e g", "erd", "eği", "i b", "kan", "ken", "man", "n k", "n s", "n y", "nra", "ok ", "onr", "r v", "rdi", "rim", "rı ", "ti ", "tme", "unu", "vey", "çok", "ım ", "şam", " an", " di", " ev", " hi", " hü", " is", " ki", " te", " çü", "a s", "a y", "ada", "ak ", "akk", "akı", "akş", "ala", "ark", "bul", "dan", "der", "dim", "eli", "emi", "erk", "eti", "ger", "git", "hiç", "ili", "ine", "ist", "içi", "kal", "kkı", "kü ", "kın", "kşa", "lac", "lan", "lla", "lma", "mın", "n o", "nde", "ne ", "nem", "nkü", "nne", "nu ", "nun", "r b", "rdı", "rek", "rke", "siy", "tan", "tir", "yem", "çin", "çün", "ün ", "ünk", "üze", "ı v", "ımı", " al", " do", " ed", " eş", " gö", " gü", " il", " mu", " ot", " yü", " za", " şe", "a a", "a d", "a h", "adı", "akt", "alı", "anl", "ant", "anu", "apt", "arl", "asa", "ati", "aç ", "ağm", "aşl", "ben", "diğ", "doğ", "dı ", "e e", "e i", "ele", "em ", "es ", "evi", "eşi", "hür", "i a", "i h", "i k", "i t", "ide", "il ", "ilm", "ima", "ind", "ini", "ins", "irl", "itm", "iz ", "iç ", "k g", "k i", "k v", "kar", "kla", "köl", "lab", "lam", "lel", "lik", "lir", "liy"}
+
+var az = []string{" və", "və ", "ər ", "lar", " hə", "in ", "ir ", " ol", " hü", " bi", "hüq", "üqu", "quq", "na ", "lər", "də ", "hər", " şə", "bir", "an ", "lik", " tə", "r b", "mal", "lma", "ası", "ini", "r h", "əxs", "şəx", "ən ", "arı", "qla", "a m", "dir", "aq ", "uqu", "ali", " ma", "una", "ilə", "ın ", "yət", " ya", "ara", "ikd", "əri", "ar ", "əsi", "əti", "r ş", "rin", "yyə", "n h", " az", "dən", "nin", "ərə", "tin", "iyy", "mək", "zad", " mü", "sin", " mə", "ni ", "nda", "ət ", "ndə", "aza", "rın", "ün ", "ını", "ə a", "i v", "nın", "olu", "qun", " qa", " et", "ilm", "lıq", "ə y", "ək ", "lmə", "lə ", "kdi", "ind", "ına", "olm", "lun", "mas", "xs ", "sın", "ə b", " in", "n m", "q v", "nə ", "əmi", "n t", "ya ", "da ", " bə", "tmə", "dlı", "adl", "bər", " on", "əya", "ə h", "sı ", "nun", "maq", "dan", "inə", "etm", "un ", "ə v", "rlə", "n b", "si ", "raq", " va", "ə m", "n a", "ınd", "rı ", "anı", " öz", "əra", "nma", "n i", "ama", "a b", "irl", "ala", "li ", "ins", "bil", "ik ", " al", " di", "ığı", "ə d", "lət", "il ", "ələ", "ə i", "ıq ", "nı ", "nla", "dil", "müd", "n v", "ə e", "unm", "alı", " sə", "xsi", "ə o", "uq ", "uql", "nsa", "ətl", " də", "ili", "üda", "asi", " he", "ola", "san", "əni", "məs", " da", "lan", " bu", "tər", "həm", "dır", "kil", "iş ", "u v", " ki", "min", "eyn", "mi ", "yin", " ha", "sos", "heç", "bu ", "eç ", " ed", "kim", "lığ", "alq", "xal", " as", "sia", "osi", "r v", "q h", "rə ", "yan", "i s", " əs", "daf", "afi", " iş", "ı h", "fiə", " ta", "ə q", "ıql", "a q", "yar", "sas", "lı ", "ill", "mil", "əsa", "liy", "tlə", "siy", "a h", "məz", "tün", "ə t", " is", "ist", "iyi", " so", "n ə", "al ", "ifa", "ina", "lıd", "ı o", "ıdı", "əmə", "ır ", "ədə", "ial", " mi", "əyi", "miy", "çün", "n e", "iya", "edi", " cə", " bü", "büt", "ütü", "xil"}
+
+var uzLatin = []string{"lar", "ish", "an ", "ga ", "ar ", " va", " bi", "da ", "va ", "ir ", " hu", "iga", "sh ", "uqu", "shi", "bir", "quq", "huq", "gan", " bo", " ha", "ini", "ng ", "a e", "r b", " ta", "lis", "ni ", "ing", "lik", "ida", "oʻl", "ili", "ari", "nin", "on ", "ins", " in", "adi", "nso", "son", "iy ", " oʻ", "lan", " ma", "dir", "hi ", "kin", "har", "i b", "ash", " yo", "boʻ", " mu", "dan", "uqi", "ila", "ega", "qla", "r i", "qig", "oʻz", " eg", "kla", "a b", "qil", "erk", "ki ", " er", "oli", "nli", "at ", " ol", "gad", "lga", "rki", "oki", "i h", "a o", " qa", "yok", "lig", "osh", "igi", "ib ", "las", "n b", "atl", "n m", " ba", "ara", " qi", "ri ", " sh", "iya", "ala", "lat", "in ", "ham", "bil", "a t", "a y", "bos", "r h", "siy", "n o", "yat", "inl", "ik ", "a q", "cha", "a h", " et", "eti", "nis", "a s", "til", "ani", "h h", "i v", "mas", "tla", "osi", "asi", " qo", "ʻli", "ati", "i m", "rni", "im ", "uql", "arn", "ris", "qar", "a i", "gi ", " da", "n h", "ha ", "sha", "i t", "mla", "rch", " xa", "i o", "li ", "hun", "bar", "lin", "ʻz ", "arc", "rla", " bu", "a m", "a a", " as", "mum", " be", " tu", "aro", "r v", "ikl", "lib", "taʼ", "h v", "tga", "tib", "un ", "lla", "mda", " ke", "shg", " to", "n q", "sid", "n e", "mat", "amd", "shu", "hga", " te", "tas", "ali", "umk", "oya", "hla", "ola", "aml", "iro", "ill", "tis", "iri", "rga", "mki", "irl", " ya", "xal", "dam", " de", "gin", "eng", "rda", "tar", "ush", "rak", "ayo", " eʼ", " so", "ten", "alq", " sa", "ur ", " is", "imo", "r t", " ki", "mil", " mi", "era", "zar", "hqa", "aza", "k b", " si", "nda", "hda", "kat", "ak ", "oʻr", "n v", "a k", "or ", "rat", "ada", "ʻlg", "miy", "tni", "i q", "shq", "oda", "shl", "bu ", "dav", "nid", "y t", "ch ", "asl", "sos", "ilg", "aso", "n t", "atn", "sin", "am ", "ti ", "as "}
+
+var uzCyr = []string{"да ", "лар", "ни ", " ке", " ва", "га ", "ва ", "им ", "ан ", "а б", " би", " бо", "ини", "ди ", "ар ", "инг", "иши", "ки ", "нг ", "нин", " та", " эр", " қи", "бир", "ги ", "ила", "ун ", "дим", "из ", "ин ", " бу", " ма", " му", " қа", "а э", "ала", "ари", "бор", "иб ", "ида", "кеч", "ча ", "чун", " ол", " ту", " ёк", "а к", "а т", "а ҳ", "ага", "ак ", "ани", "ард", "ат ", "ган", "дан", "ера", "и б", "и м", "ий ", "ир ", "йин", "кин", "миз", "н к", "ола", "он ", "са ", "ёки", " бў", " кў", " са", " ҳа", "а о", "ади", "ақи", "бўл", "и т", "ик ", "кер", "лик", "лиш", "м к", "н э", "оли", "рак", "рда", "рди", "ри ", "риш", "рла", "ши ", " да", " ка", " то", " эд", "а с", "аги", "айт", "ама", "арч", "даг", "ейи", "еч ", "и в", "и э", "иро", "кей", "лан", "ман", "мас", "она", "рта", "рча", "та ", "таъ", "эди", "эрт", "қил", " ай", " бе", " иш", " ки", " уй", " уч", " чу", " ша", " эс", " ҳе", " ҳу", "а а", "а и", "а ё", "а қ", "ада", "ам ", "ами", "аса", "бил", "бош", "бу ", "дам", "енг", "еча", "и қ", "или", "ими", "инс", "ишл", "ишн", "й ё", "лад", "лам", "либ", "м б", "мат", "н б", "над", "нги", "нки", "ор ", "ора", "ори", "р б", "р в", "р қ", "тда", "унк", "учу", "хон", "шим", "шин", "шни", "эрк", "қа ", "қла", "ҳеч", " ба", " йе", " йи", " ку", " ме", " на", " ош", " ре", " се", " со", " эм", " ян", " ёз", " ўз", " ўқ", " қо", " қу", "а в", "а м", "а ў", "айд", "атд", "бар", "бер", "бол", "був", "вим", "вчи", "г б", "г у", "гар", "дик", "ечқ", "зар", "зга", "и к", "и у", "и ў", "ига", "иди", "икд", "ирл", "йил", "кат", "кўр", "лат", "лди", "ли ", "лис", "лли", "лма", "м в", "м қ", "май", "мен", "мни", "моқ", "н д", "н с", "н я", "нга", "нид", "ниш", "нсо", "ода", "оқд", "р н", "р ё", "рга", "рки", "рун", "сен", "си ", "сиз", "син", "сон", "таб", "тил", "тоб"}
+
+var hiLatin = []string{"in ", "hai", " ha", "ain", " ka", "hi ", " me", " ma", "ar ", "ai ", " ba", " ra", " au", "he ", "na ", " ki", " na", " sa", "aur", "ur ", " pa", "e h", "ya ", " aa", "ein", "ki ", "te ", " ho", "e k", "mai", "mei", "ne ", "ta ", "ahi", "bhi", "kar", "n k", "nah", "r m", " bh", " tu", "a h", "am ", "ha ", "har", "i a", "rah", "se ", "tum", " ky", "a k", "aha", "at ", "bah", "i h", "mer", " ch", " ke", " mu", "ahu", "ana", "ata", "cha", "i b", "i m", "kya", "on ", " kh", " lo", " th", "a n", "aan", "e b", "ga ", "ho ", "hut", "jhe", "ke ", "kha", "log", "muj", "og ", "oon", "oor", "re ", "ri ", "ujh", "um ", "ut ", " do", " ga", " gh", " ta", "aam", "aar", "aat", "abh", "al ", "aro", "art", "e p", "han", "hoo", "i p", "le ", "m k", "n a", "r t", "roo", "to ", "ye ", " ag", " de", " ja", " ko", " le", " to", " wa", " za", "aap", "aay", "acc", "are", "aya", "baa", "cch", "e a", "e g", "e m", "e s", "ek ", "eri", "gar", "gha", "hna", "i t", "i z", "kal", "nga", "o b", "o k", "pad", "r a", "r k", "sab", "t k", "ung", "zar", " ac", " be", " bo", " da", " ek", " ne", " se", " ya", "a a", "a b", "a m", "a p", "a s", "a t", "ab ", "ad ", "adh", "aga", "ahe", "ais", "ak ", "alt", "apk", "apn", "b l", "bat", "bha", "ch ", "che", "doo", "e l", "e r", "e t", "eht", "gaa", "ge ", "h n", "haa", "hal", "hod", "i c", "i l", "ise", "iya", "iye", "ka ", "kaa", "kab", "ko ", "l r", "lte", "m b", "man", "mat", "n m", "n r", "or ", "r d", "ra ", "raa", "rte", "saa", "sam", "t a", "ti ", "umh", " ab", " ap", " bi", " co", " du", " is", " mi", " pe", " ph", " pi", " re", " sh", " so", "aad", "aag", "agt", "akh", "akt", "alo", "ama", "an ", "ann", "any", "ara", "as ", "ast", "aye", "az ", "beh", "bin", "ce ", "d d", "d h", "dar", "dek", "dha", "do ", "dun", "e c", "e d", "e e", "e n"}
+
+var arLatin = []string{"el ", " el", "na ", " 3a", " w ", "ana", " 7a", " an", " ma", " ba", " sa", "an ", " sh", "ak ", " en", " ko", " la", "a w", "ala", " aw", " wa", "a b", "all", "am ", "awy", "l b", "ol ", "wy ", "a f", "kol", "l s", "la ", "lla", "sa3", " be", " fi", " ka", " ta", "3al", "a m", "a3a", "ed ", "eed", "em ", "ent", "er ", "esh", "kan", "l e", "lah", "ma ", "sal", "sh ", "w e", "ya ", " ha", " kt", " me", " mo", " na", " te", " ya", "3a ", "3am", "3ar", "3as", "3me", "a s", "al ", "ane", "are", "as ", "ash", "aya", "aye", "dee", "en ", "et ", "hi ", "kra", "l 7", "l a", "l m", "la2", "mel", "nta", "ra ", "rab", "ro7", "sha", "ta ", "w a", "wal", "zem", " bo", " da", " em", " ge", " mi", " ne", " ra", " ye", "3ee", "7ag", "7el", "a 3", "a e", "a k", "a l", "a t", "a2 ", "a3 ", "a3b", "abe", "aga", "ah ", "ama", "ate", "aze", "b e", "bas", "bok", "by ", "da ", "ede", "eer", "ef ", "ek ", "fi ", "ga ", "ged", "ha ", "han", "i e", "ir ", "kon", "kti", "lam", "laz", "mes", "nak", "net", "nt ", "okr", "om ", "ont", "ref", "shi", "sho", "shu", "ta3", "tir", "wa ", "y w", " 2a", " 7e", " a3", " ah", " bs", " di", " eh", " ga", " ro", " za", "2t ", "3 b", "3an", "3ay", "3b ", "7 e", "7ab", "7ad", "7an", "7as", "7ay", "7t ", "a 7", "a n", "a2t", "a3e", "a3m", "aba", "abi", "aby", "ada", "ade", "aha", "ame", "ar ", "ar7", "asa", "aw ", "awa", "ba ", "ba3", "ba7", "bad", "ban", "bar", "ben", "bes", "bey", "bi ", "bib", "bso", "der", "di ", "e7 ", "e7a", "e7t", "egi", "eh ", "ell", "elw", "emb", "emt", "ena", "et2", "ez ", "f e", "ga3", "ghl", "h e", "h t", "hal", "hem", "hl ", "hog", "hu ", "hwa", "i m", "i w", "ib ", "ibi", "in ", "k 3", "k a", "k w", "l 2", "l 3", "l g", "l n", "l w", "lak", "m 3", "m b", "m n", "m t", "ma3", "mak", "man", "mat", "mba", "min", "mos", "n 3"}
+
+var zhLatin = []string{"ng ", "an ", " sh", "en ", " wo", "ang", "wo ", "ian", " he", " ji", " xi", "hen", "ou ", " wǒ", " zh", "ao ", "hi ", "you", " ni", " yi", " yo", "ni ", "wǒ ", " le", "de ", "ei ", "le ", "shi", " de", " qu", "iao", "jia", "men", "ong", "qu ", "uo ", "xia", "ǒu ", " ba", " ch", " ka", " nǐ", "ai ", "ba ", "fan", "g x", "i y", "ing", "kan", "mei", "nǐ ", "u h", "u y", "ào ", " gu", " me", "hì ", "i g", "i h", "ma ", "n d", "n h", "n y", "o j", "shì", "ài ", " fa", " ge", " ha", " ma", " ta", " yì", "chi", "e j", "hao", "he ", "hua", "i d", "i q", "iqi", "ián", "iān", "me ", "n l", "n m", "n s", "n x", "ngz", "nme", "o b", "o d", "o h", "o y", "qi ", "qù ", "sha", "tia", "tiā", "u s", "uan", "xue", "yi ", "zai", "àn ", "án ", "ōng", " bu", " di", " du", " hǎ", " mi", " mí", " qù", " ré", " xu", " yà", " za", " zu", " zà", " zǒ", "bu ", "e b", "e m", "han", "hu ", "hí ", "hǎo", "i s", "i w", "ie ", "iji", "in ", "jin", "jīn", "lia", "min", "mín", "n k", "n w", "ngy", "o s", "ome", "rén", "ta ", "u k", "u z", "ue ", "wom", "xie", "yiq", "yào", "yǒu", "zhō", "zài", "zǒu", "én ", "íng", "ān ", "ǎo ", " bà", " co", " da", " do", " dà", " dō", " go", " gè", " hu", " hé", " hě", " jī", " li", " mè", " mā", " qi", " su", " ti", " wa", " wǔ", " ya", " yī", " yǒ", " èr", "a s", "ban", "bia", "bàb", "con", "da ", "dif", "dou", "duo", "e d", "e h", "e n", "e q", "e s", "e x", "e z", "eng", "enm", "g z", "ge ", "gei", "ggu", "gon", "gti", "guo", "guó", "gyu", "gzu", "gè ", "hai", "hid", "hon", "hàn", "hé ", "hēn", "hěn", "hī ", "hōn", "i b", "i c", "i f", "i j", "i z", "ia ", "ifa", "ime", "iu ", "iào", "jiu", "jià", "kǒu", "mèi", "mām", "n n", "ngg", "ngt", "ngx", "nti", "o l", "o q", "osh", "qiá", "qǐ ", "she", "shu", "shí", "shē", "u c", "u g", "u n", "u r", "u w", "uì "}
+
+var jaLatin = []string{"shi", "su ", " de", " wa", "des", "wa ", "esu", "ta ", " ni", "ima", "mas", "ash", "ni ", "te ", "a d", "i d", "no ", "ou ", " ka", " no", "asu", "ita", "to ", "a n", "hi ", "hit", " to", "ata", "att", "chi", " ma", " na", " ta", " yo", "a t", "ai ", "de ", "en ", "ka ", "u n", " ik", " ne", "a i", "ara", "ii ", "mo ", "ne ", "ra ", "tta", " ga", " o ", "ana", "ga ", "i n", "ich", "o n", "osh", "tsu", "tte", " ku", " sh", " su", "e n", "hii", "ku ", "n d", "o i", "o t", "o w", "suk", " go", " ha", " it", " ko", " mo", "aka", "ari", "asa", "e k", "ha ", "him", "i i", "ite", "kat", "kim", "o s", "omo", "sen", "tas", "you", " ch", " da", " im", " is", " ky", " om", " ra", " ya", "a k", "ase", "das", "emo", "hon", "i w", "iki", "ish", "kud", "kur", "kyo", "n n", "nak", "nat", "o o", "ott", "sai", "sum", "tai", "tan", "tar", "u w", "uda", "wat", " an", " ar", " as", " mi", "a a", "a m", "a w", "ae ", "ama", "an ", "ano", "da ", "do ", "e g", "e i", "e w", "eki", "esh", "gat", "han", "i o", "i r", "i t", "i y", "iga", "ini", "kon", "mae", "mat", "mi ", "mim", "mou", "nan", "nar", "nic", "nos", "o h", "o k", "o m", "oko", "oku", "omi", "ore", "ote", "re ", "ri ", "ru ", "sha", "tem", "tot", "u g", "u k", "u y", "uka", "yas", "yo ", "yom", " at", " do", " ek", " fu", " hi", " ho", " ic", " in", " ki", " so", "a g", "a h", "a o", "a s", "a y", "aha", "aih", "aim", "ain", "ais", "aku", "ame", "ani", "ats", "cho", "dok", "e h", "e o", "ei ", "enk", "ens", "ete", "fut", "got", "goz", "hah", "hay", "hen", "hic", "hig", "hin", "hir", "ho ", "hot", "i a", "i g", "i k", "i m", "i s", "igo", "ihe", "iho", "ika", "iku", "imō", "ina", "iro", "iss", "its", "itt", "kai", "kar", "kas", "ki ", "kin", "kit", "ko ", "kor", "kos", "kou", "kyō", "ma ", "mad", "mai", "me ", "men", "mos"}
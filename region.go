@@ -0,0 +1,100 @@
+package getlang
+
+import (
+	"golang.org/x/text/language"
+	"strings"
+)
+
+// regionMarkers holds the spellings and words that tell the regional variants
+// of a language apart
+var regionMarkers = map[string]map[string][]string{
+	"en": {
+		"US": {
+			"color", "colors", "favorite", "center", "theater", "analyze", "traveled", "gray", "defense", "license",
+			"honor", "labor", "neighbor", "behavior", "humor", "flavor", "catalog", "jewelry", "aluminum", "pajamas",
+			"tire", "curb", "airplane", "fiber", "apartment", "elevator", "truck", "vacation", "gasoline", "sidewalk",
+			"diaper", "mom", "zip", "faucet", "math", "soccer", "cookie", "cookies",
+		},
+		"GB": {
+			"colour", "colours", "favourite", "centre", "theatre", "analyse", "travelled", "grey", "defence", "licence",
+			"honour", "labour", "neighbour", "behaviour", "humour", "flavour", "catalogue", "jewellery", "aluminium", "pyjamas",
+			"tyre", "kerb", "aeroplane", "fibre", "lorry", "petrol", "pavement", "nappy", "mum", "postcode",
+			"whilst", "maths", "biscuit", "biscuits", "autumn", "cheque", "programme", "learnt",
+		},
+	},
+	"es": {
+		"ES": {
+			"vosotros", "vosotras", "os", "vuestro", "vuestra", "vuestros", "vuestras", "habéis", "sois", "tenéis",
+			"estáis", "queréis", "podéis", "coche", "ordenador", "móvil", "zumo", "gafas", "conducir", "vale",
+			"coger", "patata", "patatas", "melocotón", "billete", "tío", "guay",
+		},
+		"419": {
+			"carro", "computadora", "celular", "jugo", "lentes", "manejar", "ahorita", "chévere", "papas", "durazno",
+			"boleto", "departamento", "platicar", "acá", "recién", "chamba", "pibe",
+		},
+	},
+	"pt": {
+		"BR": {
+			"você", "vocês", "ônibus", "trem", "celular", "tela", "banheiro", "fato", "ótimo", "econômico",
+			"gênero", "registro", "equipe", "usuário", "arquivo", "mouse", "geladeira", "sorvete", "suco", "cadastro",
+			"conosco", "contato", "recepção", "fazendo",
+		},
+		"PT": {
+			"tu", "vós", "facto", "óptimo", "acção", "económico", "género", "registo", "equipa", "utilizador",
+			"ficheiro", "rato", "ecrã", "frigorífico", "gelado", "sumo", "autocarro", "comboio", "telemóvel", "connosco",
+			"contacto", "receção", "estás", "miúdo", "rapariga",
+		},
+	},
+}
+
+// detectRegion adds the regional variant with the most markers in text to info
+func detectRegion(info Info, text string) Info {
+	base := info.LanguageCode()
+	markers, ok := regionMarkers[base]
+	if !ok {
+		return info
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(text), isWordSeparator)
+	regionMatches := make(map[string]int)
+	for region, regionWords := range markers {
+		regionMatches[region] = 0
+		for _, w := range words {
+			for _, m := range regionWords {
+				if w == m {
+					regionMatches[region]++
+				}
+			}
+		}
+	}
+
+	best, tied := bestRegion(regionMatches)
+	if regionMatches[best] == 0 || tied {
+		return info
+	}
+
+	region := language.MustParseRegion(best)
+	tag, err := language.Compose(info.langTag, region)
+	if err != nil {
+		return info
+	}
+	info.langTag = tag
+	info.regionProbability = softMax(regionMatches)[best]
+	return info
+}
+
+// bestRegion picks the region with the most matches and reports whether
+// another region has as many
+func bestRegion(regionMatches map[string]int) (string, bool) {
+	var best string
+	var tied bool
+	for r, n := range regionMatches {
+		switch {
+		case best == "" || n > regionMatches[best]:
+			best, tied = r, false
+		case n == regionMatches[best]:
+			tied = true
+		}
+	}
+	return best, tied
+}
@@ -0,0 +1,77 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBritishEnglish(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("My favourite colour is grey, and the theatre is in the centre of town")
+
+	assert.Equal(t, "en-GB", info.Tag().String())
+	assert.Equal(t, "en", info.LanguageCode())
+	assert.True(t, info.RegionConfidence() > 0.5)
+}
+
+func TestAmericanEnglish(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("My favorite color is gray, and the theater is in the center of town")
+
+	assert.Equal(t, "en-US", info.Tag().String())
+	assert.True(t, info.RegionConfidence() > 0.5)
+}
+
+func TestEuropeanSpanish(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("¿Vosotros queréis venir conmigo en el coche? Os espero en la puerta de la casa")
+
+	assert.Equal(t, "es-ES", info.Tag().String())
+	assert.True(t, info.RegionConfidence() > 0.5)
+}
+
+func TestLatinAmericanSpanish(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("Ahorita no puedo, dejé la computadora y el celular en el carro de mi hermano")
+
+	assert.Equal(t, "es-419", info.Tag().String())
+	assert.True(t, info.RegionConfidence() > 0.5)
+}
+
+func TestBrazilianPortuguese(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("Você pegou o ônibus ou o trem para chegar ao trabalho hoje de manhã?")
+
+	assert.Equal(t, "pt-BR", info.Tag().String())
+	assert.True(t, info.RegionConfidence() > 0.5)
+}
+
+func TestEuropeanPortuguese(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("A equipa deixou o telemóvel no autocarro e o utilizador não encontrou o ficheiro")
+
+	assert.Equal(t, "pt-PT", info.Tag().String())
+	assert.True(t, info.RegionConfidence() > 0.5)
+}
+
+func TestNoRegionalMarkers(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("The quick brown fox jumps over the lazy dog near the river bank")
+
+	assert.Equal(t, "en", info.Tag().String())
+	assert.Equal(t, 0.0, info.RegionConfidence())
+}
+
+func TestTiedRegionalMarkers(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("Some people write colour and other people write color in their letters")
+
+	assert.Equal(t, "en", info.Tag().String())
+	assert.Equal(t, 0.0, info.RegionConfidence())
+}
+
+func TestRegionsOffByDefault(t *testing.T) {
+	info := FromString("My favourite colour is grey, and the theatre is in the centre of town")
+
+	assert.Equal(t, "en", info.Tag().String())
+	assert.Equal(t, 0.0, info.RegionConfidence())
+}
+
+func TestRegionsIgnoreOtherLanguages(t *testing.T) {
+	info, _ := Detector{Regions: true}.FromString("Je voudrais une tasse de café avec du lait et du sucre, s'il vous plaît")
+
+	assert.Equal(t, "fr", info.Tag().String())
+	assert.Equal(t, 0.0, info.RegionConfidence())
+}
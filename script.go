@@ -0,0 +1,238 @@
+package getlang
+
+import (
+	"golang.org/x/text/language"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// scriptCode pairs a Unicode script with its ISO 15924 code
+type scriptCode struct {
+	table *unicode.RangeTable
+	code  language.Script
+}
+
+// scriptCodes lists the scripts DetectScript knows, most widely used first so
+// that the common ones are found quickly
+var scriptCodes = []scriptCode{
+	{unicode.Latin, language.MustParseScript("Latn")},
+	{unicode.Cyrillic, language.MustParseScript("Cyrl")},
+	{unicode.Han, language.MustParseScript("Hani")},
+	{unicode.Arabic, language.MustParseScript("Arab")},
+	{unicode.Devanagari, language.MustParseScript("Deva")},
+	{unicode.Bengali, language.MustParseScript("Beng")},
+	{unicode.Hiragana, language.MustParseScript("Hira")},
+	{unicode.Katakana, language.MustParseScript("Kana")},
+	{unicode.Hangul, language.MustParseScript("Hang")},
+	{unicode.Thai, language.MustParseScript("Thai")},
+	{unicode.Greek, language.MustParseScript("Grek")},
+	{unicode.Hebrew, language.MustParseScript("Hebr")},
+	{unicode.Ethiopic, language.MustParseScript("Ethi")},
+	{unicode.Tamil, language.MustParseScript("Taml")},
+	{unicode.Telugu, language.MustParseScript("Telu")},
+	{unicode.Kannada, language.MustParseScript("Knda")},
+	{unicode.Malayalam, language.MustParseScript("Mlym")},
+	{unicode.Gujarati, language.MustParseScript("Gujr")},
+	{unicode.Gurmukhi, language.MustParseScript("Guru")},
+	{unicode.Oriya, language.MustParseScript("Orya")},
+	{unicode.Sinhala, language.MustParseScript("Sinh")},
+	{unicode.Myanmar, language.MustParseScript("Mymr")},
+	{unicode.Khmer, language.MustParseScript("Khmr")},
+	{unicode.Lao, language.MustParseScript("Laoo")},
+	{unicode.Tibetan, language.MustParseScript("Tibt")},
+	{unicode.Armenian, language.MustParseScript("Armn")},
+	{unicode.Georgian, language.MustParseScript("Geor")},
+	{unicode.Thaana, language.MustParseScript("Thaa")},
+	{unicode.Syriac, language.MustParseScript("Syrc")},
+	{unicode.Mongolian, language.MustParseScript("Mong")},
+	{unicode.Bopomofo, language.MustParseScript("Bopo")},
+	{unicode.Tifinagh, language.MustParseScript("Tfng")},
+	{unicode.Canadian_Aboriginal, language.MustParseScript("Cans")},
+	{unicode.Cherokee, language.MustParseScript("Cher")},
+	{unicode.Yi, language.MustParseScript("Yiii")},
+	{unicode.Javanese, language.MustParseScript("Java")},
+	{unicode.Balinese, language.MustParseScript("Bali")},
+	{unicode.Sundanese, language.MustParseScript("Sund")},
+	{unicode.Ol_Chiki, language.MustParseScript("Olck")},
+	{unicode.Nko, language.MustParseScript("Nkoo")},
+	{unicode.Vai, language.MustParseScript("Vaii")},
+	{unicode.Adlam, language.MustParseScript("Adlm")},
+}
+
+var (
+	hani = language.MustParseScript("Hani")
+	hira = language.MustParseScript("Hira")
+	kana = language.MustParseScript("Kana")
+	hang = language.MustParseScript("Hang")
+	jpan = language.MustParseScript("Jpan")
+	kore = language.MustParseScript("Kore")
+)
+
+// scriptFamilies lists the scripts combined in Jpan and Kore
+var scriptFamilies = map[language.Script][]language.Script{
+	jpan: {hani, hira, kana},
+	kore: {hani, hang},
+}
+
+// ScriptInfo is the script detection result
+type ScriptInfo struct {
+	script      language.Script
+	proportions map[language.Script]float64
+}
+
+// Script returns the ISO 15924 code of the script most of the letters are
+// written in, or Zzzz when the text has no letters
+//
+// Han mixed with kana is reported as Jpan and Han mixed with Hangul as Kore,
+// since that is what fonts and shaping care about
+func (s ScriptInfo) Script() language.Script {
+	return s.script
+}
+
+// Proportions returns the share of the letters written in each script; letters
+// of scripts DetectScript does not know are counted as Zzzz
+//
+// The shares add up to 1 unless the text has no letters
+func (s ScriptInfo) Proportions() map[language.Script]float64 {
+	proportions := make(map[language.Script]float64, len(s.proportions))
+	for k, v := range s.proportions {
+		proportions[k] = v
+	}
+	return proportions
+}
+
+// DetectScript finds the scripts a text is written in
+func DetectScript(text string) ScriptInfo {
+	counts, total := countScripts(text)
+	info := ScriptInfo{proportions: make(map[language.Script]float64, len(counts))}
+	if total == 0 {
+		return info
+	}
+
+	for k, n := range counts {
+		info.proportions[k] = float64(n) / float64(total)
+	}
+	info.script = dominantScript(counts)
+	return info
+}
+
+// dominantScript picks the script with the most letters, or Zzzz for none
+func dominantScript(counts map[language.Script]int) language.Script {
+	var script language.Script
+	var best int
+	for k, n := range counts {
+		if n > best || n == best && k.String() < script.String() {
+			script, best = k, n
+		}
+	}
+
+	switch {
+	case counts[hira]+counts[kana] > 0 && scriptLetters(counts, jpan) > best:
+		return jpan
+	case counts[hang] > 0 && scriptLetters(counts, kore) > best:
+		return kore
+	}
+	return script
+}
+
+// countScripts counts the letters and combining marks of each script in text;
+// marks inherited from the letter before them are not counted
+func countScripts(text string) (map[language.Script]int, int) {
+	counts := make(map[language.Script]int)
+	var total int
+	for _, r := range text {
+		if !unicode.IsLetter(r) && !unicode.IsMark(r) {
+			continue
+		}
+		script, ok := scriptOf(r)
+		if !ok && unicode.IsMark(r) {
+			continue
+		}
+		counts[script]++
+		total++
+	}
+	return counts, total
+}
+
+// scriptOf returns the script of r, or Zzzz and false for a script that is
+// not in scriptCodes
+func scriptOf(r rune) (language.Script, bool) {
+	for _, s := range scriptCodes {
+		if unicode.Is(s.table, r) {
+			return s.code, true
+		}
+	}
+	return language.Script{}, false
+}
+
+// scriptLanguages maps each script to the languages written in it, in
+// alphabetical order
+var scriptLanguages = languagesByScript()
+
+func languagesByScript() map[language.Script][]string {
+	sets := make(map[language.Script]map[string]bool)
+	add := func(script language.Script, lang string) {
+		if sets[script] == nil {
+			sets[script] = make(map[string]bool)
+		}
+		sets[script][lang] = true
+	}
+
+	for k, profile := range langs {
+		add(DetectScript(strings.Join(profile, " ")).Script(), k)
+	}
+	for k, tables := range scripts {
+		for _, table := range tables {
+			add(scriptCodeOf(table), k)
+		}
+	}
+	for table, names := range sharedScripts {
+		for _, k := range names {
+			add(scriptCodeOf(table), k)
+		}
+	}
+	// Jpan and Kore belong to the languages written in kana and Hangul
+	for k := range sets[hira] {
+		add(jpan, k)
+	}
+	for k := range sets[kana] {
+		add(jpan, k)
+	}
+	for k := range sets[hang] {
+		add(kore, k)
+	}
+
+	result := make(map[language.Script][]string)
+	for script, set := range sets {
+		for k := range set {
+			result[script] = append(result[script], k)
+		}
+		sort.Strings(result[script])
+	}
+	return result
+}
+
+// scriptCodeOf returns the ISO 15924 code of a script in scriptCodes
+func scriptCodeOf(table *unicode.RangeTable) language.Script {
+	for _, s := range scriptCodes {
+		if s.table == table {
+			return s.code
+		}
+	}
+	return language.Script{}
+}
+
+// scriptLetters counts the letters of a script, including those of the
+// scripts that make up Jpan and Kore
+func scriptLetters(counts map[language.Script]int, script language.Script) int {
+	parts, ok := scriptFamilies[script]
+	if !ok {
+		return counts[script]
+	}
+	var n int
+	for _, part := range parts {
+		n += counts[part]
+	}
+	return n
+}
@@ -0,0 +1,90 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+func TestDetectScript(t *testing.T) {
+	tests := map[string]string{
+		"The quick brown fox jumps over the lazy dog": "Latn",
+		"Съешь же ещё этих мягких французских булок":  "Cyrl",
+		"我们都是中国人":          "Hani",
+		"何ですか？日本語を勉強しています": "Jpan",
+		"안녕하세요 만나서 반갑습니다":  "Hang",
+		"大韓民國 대한민국":        "Kore",
+		"नमस्ते दुनिया":    "Deva",
+		"Γειά σου κόσμε":   "Grek",
+		"ሰላም ለዓለም":         "Ethi",
+		"12345 !!!":        "Zzzz",
+		"":                 "Zzzz",
+	}
+	for text, expected := range tests {
+		assert.Equal(t, expected, DetectScript(text).Script().String(), text)
+	}
+}
+
+func TestDetectScriptProportions(t *testing.T) {
+	proportions := DetectScript("Hello, мир!").Proportions()
+
+	assert.Equal(t, 2, len(proportions))
+	assert.InDelta(t, 5.0/8, proportions[language.MustParseScript("Latn")], 1e-9)
+	assert.InDelta(t, 3.0/8, proportions[language.MustParseScript("Cyrl")], 1e-9)
+}
+
+func TestDetectScriptCountsMarksWithTheirScript(t *testing.T) {
+	proportions := DetectScript("नमस्ते").Proportions()
+
+	assert.Equal(t, map[language.Script]float64{language.MustParseScript("Deva"): 1}, proportions)
+}
+
+func TestDetectScriptSkipsInheritedMarks(t *testing.T) {
+	proportions := DetectScript("café").Proportions()
+
+	assert.Equal(t, map[language.Script]float64{language.MustParseScript("Latn"): 1}, proportions)
+}
+
+func TestDetectScriptUnknownScript(t *testing.T) {
+	info := DetectScript("𐌰𐌱𐌲 abc")
+
+	assert.Equal(t, "Latn", info.Script().String())
+	assert.InDelta(t, 0.5, info.Proportions()[language.Script{}], 1e-9)
+}
+
+func TestProportionsAreCopied(t *testing.T) {
+	info := DetectScript("abc")
+	info.Proportions()[language.MustParseScript("Latn")] = 0
+
+	assert.Equal(t, 1.0, info.Proportions()[language.MustParseScript("Latn")])
+}
+
+func TestScriptLanguages(t *testing.T) {
+	assert.Equal(t, []string{"ru", "sr-Cyrl", "uk", "uz-Cyrl"}, scriptLanguages[language.MustParseScript("Cyrl")])
+	assert.Equal(t, []string{"am", "ti"}, scriptLanguages[language.MustParseScript("Ethi")])
+	assert.Equal(t, []string{"as", "bn"}, scriptLanguages[language.MustParseScript("Beng")])
+	assert.Equal(t, []string{"ja", "ko", "zh"}, scriptLanguages[hani])
+	assert.Equal(t, []string{"ja"}, scriptLanguages[jpan])
+	assert.Equal(t, []string{"ko"}, scriptLanguages[kore])
+	assert.Equal(t, []string{"el"}, scriptLanguages[language.MustParseScript("Grek")])
+	assert.Equal(t, []string{"hi"}, scriptLanguages[language.MustParseScript("Deva")])
+	assert.Contains(t, scriptLanguages[language.MustParseScript("Latn")], "en")
+	assert.NotContains(t, scriptLanguages[language.MustParseScript("Latn")], "ru")
+	assert.Empty(t, scriptLanguages[language.Script{}])
+}
+
+func TestMatchAllScoresOnlyTheDominantScript(t *testing.T) {
+	langMatches := matchAll("Все люди рождаются свободными и равными в своем достоинстве и правах", TrigramModel(), false)
+
+	assert.Zero(t, langMatches["en"])
+	assert.True(t, langMatches["ru"] > 0)
+}
+
+func TestMatchAllUniqueScript(t *testing.T) {
+	assert.Equal(t, map[string]int{"und": 1, "hy": 4 * scriptCountFactor}, matchAll("բարև", TrigramModel(), false))
+	assert.Equal(t, map[string]int{"und": 1, "el": 4 * scriptCountFactor}, matchAll("Γειά!", TrigramModel(), false))
+}
+
+func TestMatchAllWithoutLetters(t *testing.T) {
+	assert.Equal(t, map[string]int{"und": 1}, matchAll("12345 !!!", TrigramModel(), false))
+}
@@ -0,0 +1,182 @@
+package getlang
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cue is a single subtitle and the language of its text
+type Cue struct {
+	// Index is the position of the cue in the track, starting at 1
+	Index int `json:"index"`
+	// ID is the cue number of an SRT file or the optional identifier of a WebVTT cue
+	ID    string        `json:"id,omitempty"`
+	Start time.Duration `json:"start"`
+	End   time.Duration `json:"end"`
+	// Text is the cue text without styling tags
+	Text string `json:"text"`
+	Info Info   `json:"info"`
+}
+
+// Subtitles is the language detection result for a subtitle track
+//
+// The embedded Info is the language of the whole track
+type Subtitles struct {
+	Info
+	Cues []Cue
+}
+
+// subtitlesJSON is the JSON form of Subtitles; the MarshalJSON of the
+// embedded Info would otherwise leave out the cues
+type subtitlesJSON struct {
+	Info Info  `json:"info"`
+	Cues []Cue `json:"cues"`
+}
+
+// MarshalJSON writes the Info of the whole track next to the cues
+func (s Subtitles) MarshalJSON() ([]byte, error) {
+	return json.Marshal(subtitlesJSON{Info: s.Info, Cues: s.Cues})
+}
+
+// UnmarshalJSON reads an object written by MarshalJSON
+func (s *Subtitles) UnmarshalJSON(data []byte) error {
+	var v subtitlesJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = Subtitles{Info: v.Info, Cues: v.Cues}
+	return nil
+}
+
+var (
+	subtitleBlank  = regexp.MustCompile(`\n[ \t]*\n`)
+	subtitleTag    = regexp.MustCompile(`<[^>]*>|\{\\[^}]*\}`)
+	subtitleTiming = regexp.MustCompile(`^\s*(\S+)\s+-->\s+(\S+)`)
+)
+
+// FromSRT detects the language of a SubRip track and of each of its cues
+//
+// This function will read all bytes until an EOF is reached
+func FromSRT(reader io.Reader) (Subtitles, error) {
+	return defaultDetector.FromSRT(reader)
+}
+
+// FromWebVTT detects the language of a WebVTT track and of each of its cues
+//
+// This function will read all bytes until an EOF is reached
+func FromWebVTT(reader io.Reader) (Subtitles, error) {
+	return defaultDetector.FromWebVTT(reader)
+}
+
+// FromSRT detects the language of a SubRip track and of each of its cues
+func (d Detector) FromSRT(reader io.Reader) (Subtitles, error) {
+	return d.fromSubtitles(reader, "srt")
+}
+
+// FromWebVTT detects the language of a WebVTT track and of each of its cues
+func (d Detector) FromWebVTT(reader io.Reader) (Subtitles, error) {
+	return d.fromSubtitles(reader, "webvtt")
+}
+
+func (d Detector) fromSubtitles(reader io.Reader, format string) (Subtitles, error) {
+	b, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return Subtitles{Info: Info{lang: undetermined, langTag: undeterminedTag, extractor: format}}, err
+	}
+
+	cs, document := decode(b)
+	cues, err := parseCues(document, format == "webvtt")
+	if err != nil {
+		return Subtitles{Info: Info{lang: undetermined, langTag: undeterminedTag, charset: cs.name, extractor: format}}, err
+	}
+
+	texts := make([]string, len(cues))
+	for i := range cues {
+		cues[i].Info, _ = d.FromString(cues[i].Text)
+		texts[i] = cues[i].Text
+	}
+
+	info, err := d.FromString(strings.Join(texts, "\n"))
+	info.charset = cs.name
+	info.extractor = format
+	return Subtitles{Info: info, Cues: cues}, err
+}
+
+// parseCues splits a track into blocks separated by blank lines; WebVTT
+// header, NOTE, STYLE and REGION blocks are skipped
+func parseCues(document string, webvtt bool) ([]Cue, error) {
+	document = strings.Replace(document, "\r\n", "\n", -1)
+	blocks := subtitleBlank.Split(strings.TrimSpace(document), -1)
+	if webvtt {
+		if len(blocks) == 0 || !strings.HasPrefix(blocks[0], "WEBVTT") {
+			return nil, errors.New("getlang: missing WEBVTT header")
+		}
+		blocks = blocks[1:]
+	}
+
+	var cues []Cue
+	for _, block := range blocks {
+		lines := strings.Split(block, "\n")
+		timing := 0
+		for timing < len(lines) && !strings.Contains(lines[timing], "-->") {
+			timing++
+		}
+		if timing == len(lines) || timing > 1 {
+			continue
+		}
+
+		cue := Cue{Index: len(cues) + 1}
+		if timing == 1 {
+			cue.ID = strings.TrimSpace(lines[0])
+		}
+
+		m := subtitleTiming.FindStringSubmatch(lines[timing])
+		if m == nil {
+			return nil, fmt.Errorf("getlang: invalid cue timing %q", lines[timing])
+		}
+		var err error
+		if cue.Start, err = parseTimestamp(m[1]); err != nil {
+			return nil, err
+		}
+		if cue.End, err = parseTimestamp(m[2]); err != nil {
+			return nil, err
+		}
+
+		text := strings.Join(lines[timing+1:], "\n")
+		cue.Text = html.UnescapeString(subtitleTag.ReplaceAllString(text, ""))
+		cues = append(cues, cue)
+	}
+	return cues, nil
+}
+
+// parseTimestamp reads [hh:]mm:ss,mmm and [hh:]mm:ss.mmm timestamps
+func parseTimestamp(s string) (time.Duration, error) {
+	parts := strings.Split(strings.Replace(s, ",", ".", 1), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("getlang: invalid timestamp %q", s)
+	}
+
+	seconds, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("getlang: invalid timestamp %q", s)
+	}
+	total := time.Duration(seconds * float64(time.Second))
+
+	units := []time.Duration{time.Minute, time.Hour}
+	for i, part := range parts[:len(parts)-1] {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("getlang: invalid timestamp %q", s)
+		}
+		total += time.Duration(n) * units[len(parts)-2-i]
+	}
+	return total.Round(time.Millisecond), nil
+}
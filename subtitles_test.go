@@ -0,0 +1,103 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+const srtTrack = "1\r\n" +
+	"00:00:01,000 --> 00:00:04,250\r\n" +
+	"<i>Alle Menschen sind frei und gleich an Würde und Rechten geboren.</i>\r\n" +
+	"\r\n" +
+	"2\r\n" +
+	"00:00:05,000 --> 00:00:08,500\r\n" +
+	"{\\an8}Sie sind mit Vernunft und Gewissen begabt\r\n" +
+	"und sollen einander im Geist der Brüderlichkeit begegnen.\r\n" +
+	"\r\n" +
+	"3\r\n" +
+	"01:02:03,004 --> 01:02:06,000\r\n" +
+	"All human beings are born free and equal in dignity and rights.\r\n"
+
+const webvttTrack = `WEBVTT - Allgemeine Erklärung
+
+NOTE Diese Spur ist ein Beispiel
+
+STYLE
+::cue { color: yellow }
+
+intro
+00:01.000 --> 00:04.000 align:start position:10%
+<v Sprecher>Tous les êtres humains naissent libres et égaux en dignité et en droits.</v>
+
+00:00:05.000 --> 00:00:09.000
+<c.yellow>Ils sont doués de raison et de conscience</c> <00:00:07.000>et doivent agir les uns envers les autres dans un esprit de fraternité.
+`
+
+func TestFromSRT(t *testing.T) {
+	track, err := FromSRT(strings.NewReader(srtTrack))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "de", track.LanguageCode())
+	assert.Equal(t, "srt", track.Extractor())
+	assert.Equal(t, 3, len(track.Cues))
+
+	cue := track.Cues[1]
+	assert.Equal(t, 2, cue.Index)
+	assert.Equal(t, "2", cue.ID)
+	assert.Equal(t, 5*time.Second, cue.Start)
+	assert.Equal(t, 8500*time.Millisecond, cue.End)
+	assert.Equal(t, "Sie sind mit Vernunft und Gewissen begabt\nund sollen einander im Geist der Brüderlichkeit begegnen.", cue.Text)
+	assert.Equal(t, "de", cue.Info.LanguageCode())
+
+	assert.Equal(t, "Alle Menschen sind frei und gleich an Würde und Rechten geboren.", track.Cues[0].Text)
+	assert.Equal(t, time.Hour+2*time.Minute+3*time.Second+4*time.Millisecond, track.Cues[2].Start)
+	assert.Equal(t, "en", track.Cues[2].Info.LanguageCode())
+}
+
+func TestFromWebVTT(t *testing.T) {
+	track, err := FromWebVTT(strings.NewReader(webvttTrack))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "fr", track.LanguageCode())
+	assert.Equal(t, "webvtt", track.Extractor())
+	assert.Equal(t, 2, len(track.Cues))
+
+	assert.Equal(t, "intro", track.Cues[0].ID)
+	assert.Equal(t, time.Second, track.Cues[0].Start)
+	assert.Equal(t, "Tous les êtres humains naissent libres et égaux en dignité et en droits.", track.Cues[0].Text)
+
+	assert.Equal(t, "", track.Cues[1].ID)
+	assert.Equal(t, 2, track.Cues[1].Index)
+	assert.Equal(t, 9*time.Second, track.Cues[1].End)
+	assert.Equal(t, "Ils sont doués de raison et de conscience et doivent agir les uns envers les autres dans un esprit de fraternité.", track.Cues[1].Text)
+	assert.Equal(t, "fr", track.Cues[1].Info.LanguageCode())
+}
+
+func TestFromWebVTTMissingHeader(t *testing.T) {
+	_, err := FromWebVTT(strings.NewReader(srtTrack))
+
+	assert.NotNil(t, err)
+}
+
+func TestFromSRTInvalidTimestamp(t *testing.T) {
+	_, err := FromSRT(strings.NewReader("1\n00:00:xx,000 --> 00:00:02,000\nHallo\n"))
+
+	assert.NotNil(t, err)
+}
+
+func TestParseTimestamp(t *testing.T) {
+	for s, expected := range map[string]time.Duration{
+		"00:00:01,500": 1500 * time.Millisecond,
+		"01:00:00.000": time.Hour,
+		"12:34.567":    12*time.Minute + 34567*time.Millisecond,
+	} {
+		d, err := parseTimestamp(s)
+		assert.Nil(t, err, s)
+		assert.Equal(t, expected, d, s)
+	}
+
+	_, err := parseTimestamp("1:2:3:4")
+	assert.NotNil(t, err)
+}
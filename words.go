@@ -0,0 +1,299 @@
+package getlang
+
+import (
+	"strings"
+	"unicode"
+)
+
+const wordCountFactor int = 4
+
+// frequentWords holds the most frequent words of each language: function
+// words, common verbs and everyday phrases
+//
+// Han and kana are split into single characters, so the Chinese and Japanese
+// lists hold characters
+var frequentWords = map[string][]string{
+	"am": {"እና", "ነው", "ላይ", "ውስጥ", "ግን", "ይህ", "ወደ", "ጋር", "ምን", "አለ", "የለም", "አዎ", "እኔ", "አንተ", "እሱ", "በጣም"},
+	"ar-Latn": {
+		"ana", "enta", "inta", "enti", "howa", "heya", "ya", "w", "fe", "fi", "men", "min", "ala", "la", "mesh", "mish",
+		"shukran", "habibi", "yalla", "inshallah", "keefak", "kifak", "wallah", "fein", "feen", "ahlan", "marhaba", "aywa",
+	},
+	"az": {
+		"və", "bir", "bu", "da", "də", "ki", "üçün", "ilə", "çox", "nə", "amma", "kimi", "daha", "var", "yox", "mən",
+		"sən", "o", "biz", "siz", "olan", "olaraq", "təşəkkür", "edirəm", "salam", "bəli", "harada", "necə",
+	},
+	"ca": {
+		"de", "la", "que", "el", "i", "a", "en", "les", "per", "un", "una", "del", "es", "no", "amb", "als",
+		"com", "més", "però", "els", "al", "seu", "també", "hi", "ha", "molt", "gràcies", "hola", "sí", "bon", "on", "és",
+	},
+	"cs": {
+		"a", "v", "se", "na", "je", "že", "s", "z", "o", "to", "i", "do", "ve", "jsem", "k", "by",
+		"ale", "jak", "pro", "tak", "jsou", "není", "co", "už", "děkuji", "ano", "ne", "dobrý", "prosím", "kde", "ahoj", "moc",
+	},
+	"de": {
+		"der", "die", "und", "in", "den", "von", "zu", "das", "mit", "sich", "des", "auf", "für", "ist", "im", "dem",
+		"nicht", "ein", "eine", "als", "auch", "es", "an", "er", "hat", "aus", "bei", "sind", "noch", "wie", "über", "so",
+		"zum", "war", "haben", "nur", "oder", "aber", "ich", "sie", "wir", "du", "ja", "nein", "danke", "schön", "bitte",
+		"gut", "wo", "was", "heute", "guten", "tag",
+	},
+	"en": {
+		"the", "be", "to", "of", "and", "a", "in", "that", "have", "i", "it", "for", "not", "on", "with", "he",
+		"as", "you", "do", "at", "this", "but", "his", "by", "from", "they", "we", "say", "her", "she", "or", "will",
+		"my", "one", "all", "would", "there", "their", "what", "so", "if", "about", "who", "get", "which", "is", "was",
+		"are", "thank", "thanks", "please", "hello", "yes", "where", "how", "much",
+	},
+	"es": {
+		"de", "la", "que", "el", "en", "y", "a", "los", "se", "del", "las", "un", "por", "con", "no", "una",
+		"su", "para", "es", "al", "lo", "como", "más", "pero", "sus", "le", "ya", "o", "este", "sí", "porque", "esta",
+		"cuando", "muy", "sin", "sobre", "también", "me", "hay", "donde", "dónde", "gracias", "hola", "bueno", "está", "buenos", "días",
+	},
+	"fr": {
+		"de", "la", "le", "et", "les", "des", "en", "un", "du", "une", "que", "est", "pour", "qui", "dans", "par",
+		"plus", "pas", "au", "sur", "ne", "se", "il", "elle", "ce", "avec", "nous", "vous", "je", "tu", "mais", "sont",
+		"ou", "son", "été", "cette", "aux", "merci", "beaucoup", "bonjour", "oui", "non", "très", "bien", "où", "suis", "voudrais",
+	},
+	"gl": {
+		"de", "a", "o", "que", "e", "do", "da", "en", "un", "para", "é", "con", "non", "unha", "os", "no",
+		"se", "na", "por", "máis", "as", "dos", "como", "pero", "foi", "ao", "el", "das", "ten", "moi", "grazas", "ola",
+		"si", "onde", "tamén",
+	},
+	"ha": {
+		"da", "na", "a", "ta", "ya", "ba", "ne", "ce", "wannan", "shi", "ita", "ni", "kai", "suka", "don", "amma",
+		"sannu", "nagode", "yauwa", "ina", "kuma", "zuwa", "akwai", "lafiya",
+	},
+	"hi": {
+		"है", "के", "में", "की", "और", "को", "से", "का", "यह", "नहीं", "हैं", "पर", "भी", "तो", "कि", "एक",
+		"मैं", "आप", "हम", "धन्यवाद", "बहुत", "क्या", "कहाँ", "नमस्ते",
+	},
+	"hi-Latn": {
+		"hai", "hain", "ka", "ki", "ke", "mein", "se", "ko", "aur", "nahi", "nahin", "kya", "yeh", "woh", "bhi", "tum",
+		"aap", "main", "hum", "bahut", "dhanyavaad", "shukriya", "kahan", "kaise", "namaste", "haan", "accha", "bhai",
+	},
+	"hr": {
+		"i", "je", "u", "se", "na", "da", "su", "za", "od", "s", "a", "to", "ne", "kao", "koji", "što",
+		"ali", "iz", "sam", "bi", "hvala", "lijepa", "molim", "dobar", "gdje", "dan", "lijepo", "bok",
+	},
+	"hu": {
+		"a", "az", "és", "hogy", "nem", "is", "egy", "van", "ez", "de", "meg", "el", "csak", "már", "mint", "volt",
+		"vagy", "még", "ki", "be", "köszönöm", "szépen", "igen", "jó", "hol", "kérem", "napot", "szia",
+	},
+	"id": {
+		"yang", "dan", "di", "ini", "itu", "dengan", "untuk", "tidak", "dari", "dalam", "akan", "pada", "juga", "ke", "saya", "ada",
+		"bisa", "karena", "kamu", "terima", "kasih", "apa", "selamat", "pagi", "mana", "banyak",
+	},
+	"it": {
+		"di", "e", "il", "la", "che", "è", "per", "un", "in", "del", "non", "una", "sono", "le", "si", "da",
+		"con", "ma", "come", "lo", "della", "al", "dei", "più", "anche", "mi", "ci", "io", "questo", "ha", "ho", "grazie",
+		"mille", "ciao", "buongiorno", "sì", "molto", "cosa", "dove", "perché",
+	},
+	"ja": {
+		"の", "に", "は", "を", "た", "が", "で", "て", "と", "し", "れ", "さ", "も", "な", "か", "だ",
+		"す", "ま", "い", "う", "気", "駅", "円", "図", "込", "働", "様", "私",
+	},
+	"ja-Latn": {
+		"wa", "ga", "no", "ni", "wo", "de", "to", "mo", "desu", "masu", "watashi", "anata", "arigatou", "arigato", "gozaimasu", "konnichiwa",
+		"hai", "iie", "doko", "sumimasen", "kore", "sore", "nani", "kudasai",
+	},
+	"ms": {
+		"yang", "dan", "di", "ini", "itu", "dengan", "untuk", "tidak", "dari", "dalam", "akan", "pada", "juga", "ke", "saya", "ada",
+		"boleh", "kerana", "awak", "terima", "kasih", "apa", "selamat", "pagi", "mana", "banyak",
+	},
+	"nl": {
+		"de", "het", "een", "en", "van", "in", "is", "dat", "op", "te", "zijn", "met", "voor", "niet", "aan", "er",
+		"die", "om", "ook", "als", "maar", "bij", "dan", "nog", "wel", "ik", "je", "jij", "we", "hij", "zij", "was",
+		"heb", "heeft", "dank", "bedankt", "hallo", "ja", "nee", "goed", "waar", "goedemorgen",
+	},
+	"pl": {
+		"w", "i", "na", "z", "się", "nie", "do", "to", "że", "jest", "o", "a", "jak", "ale", "co", "tak",
+		"za", "po", "od", "dla", "są", "ja", "ty", "już", "tylko", "był", "dziękuję", "bardzo", "proszę", "dzień", "dobry", "gdzie",
+		"cześć",
+	},
+	"pt": {
+		"de", "a", "o", "que", "e", "do", "da", "em", "um", "para", "é", "com", "não", "uma", "os", "no",
+		"se", "na", "por", "mais", "as", "dos", "como", "mas", "foi", "ao", "ele", "das", "tem", "à", "seu", "sua",
+		"ou", "ser", "quando", "muito", "há", "nos", "já", "está", "eu", "também", "só", "obrigado", "obrigada", "olá", "sim", "você",
+	},
+	"ro": {
+		"de", "și", "şi", "în", "a", "la", "cu", "pe", "nu", "un", "o", "care", "este", "că", "din", "se",
+		"pentru", "mai", "sunt", "sau", "dar", "ce", "ca", "le", "lui", "am", "fost", "mulțumesc", "mulţumesc", "bună", "da", "foarte",
+		"unde",
+	},
+	"ru": {
+		"и", "в", "не", "на", "я", "что", "он", "с", "как", "а", "то", "все", "она", "так", "его", "но",
+		"да", "ты", "к", "у", "же", "вы", "за", "бы", "по", "её", "мне", "было", "вот", "от", "меня", "о",
+		"из", "спасибо", "большое", "привет", "хорошо", "где", "пожалуйста", "это",
+	},
+	"sk": {
+		"a", "v", "sa", "na", "je", "že", "s", "z", "o", "to", "i", "do", "vo", "som", "k", "by",
+		"ale", "ako", "pre", "tak", "sú", "nie", "čo", "už", "ďakujem", "áno", "dobrý", "prosím", "kde", "ahoj", "veľmi",
+	},
+	"sl": {
+		"in", "je", "v", "se", "na", "da", "so", "za", "od", "z", "ki", "to", "ne", "kot", "pa", "tudi",
+		"ali", "iz", "sem", "bi", "hvala", "lepa", "prosim", "dober", "kje", "dan", "zelo",
+	},
+	"so": {
+		"iyo", "ka", "waa", "oo", "u", "ku", "ayaa", "la", "ee", "in", "aan", "uu", "ay", "si", "ma", "mahadsanid",
+		"haa", "maya", "nabad", "waxaan", "waxa",
+	},
+	"sr-Cyrl": {
+		"и", "је", "у", "се", "на", "да", "су", "за", "од", "са", "а", "то", "не", "као", "који", "што",
+		"али", "из", "сам", "би", "хвала", "лепо", "молим", "добар", "где", "дан",
+	},
+	"sr-Latn": {
+		"i", "je", "u", "se", "na", "da", "su", "za", "od", "sa", "a", "to", "ne", "kao", "koji", "što",
+		"ali", "iz", "sam", "bi", "hvala", "lepo", "molim", "dobar", "gde", "dan",
+	},
+	"sw": {
+		"na", "ya", "wa", "kwa", "ni", "za", "katika", "la", "au", "kama", "hii", "hiyo", "lakini", "mimi", "wewe", "yeye",
+		"sana", "asante", "habari", "ndiyo", "hapana", "wapi", "karibu",
+	},
+	"th": {
+		"ที่", "และ", "ใน", "การ", "ของ", "เป็น", "ได้", "มี", "ไม่", "จะ", "ให้", "ว่า", "ครับ", "ค่ะ", "ขอบคุณ", "สวัสดี",
+		"มาก", "นี้", "ไป", "คน",
+	},
+	"ti": {"ኣብ", "ናይ", "እዩ", "ምስ", "እዚ", "ከኣ", "ኣይ", "ከም", "እዚኣ", "ኣሎ", "የለን", "እወ", "ኣነ", "ንስኻ", "ንሱ", "የቐንየለይ"},
+	"tl": {
+		"ang", "ng", "sa", "na", "mga", "at", "ay", "si", "ko", "mo", "ako", "ka", "hindi", "siya", "ito", "para",
+		"kung", "salamat", "po", "opo", "oo", "magandang", "umaga", "saan",
+	},
+	"tr": {
+		"bir", "ve", "bu", "da", "de", "için", "ile", "çok", "ne", "ama", "gibi", "daha", "olarak", "var", "yok", "ben",
+		"sen", "o", "teşekkür", "ederim", "evet", "hayır", "merhaba", "nerede", "nasıl",
+	},
+	"uk": {
+		"і", "в", "не", "на", "я", "що", "він", "з", "як", "а", "то", "все", "вона", "так", "його", "але",
+		"та", "ти", "до", "у", "вже", "ви", "за", "б", "по", "її", "мені", "було", "ось", "від", "мене", "про",
+		"дякую", "дуже", "привіт", "добре", "де", "будь", "ласка", "це",
+	},
+	"uz-Cyrl": {
+		"ва", "бу", "бир", "ҳам", "учун", "билан", "мен", "сен", "у", "эмас", "бор", "йўқ", "раҳмат", "салом", "қаерда", "жуда",
+	},
+	"uz-Latn": {
+		"va", "bu", "bir", "ham", "uchun", "bilan", "men", "sen", "u", "emas", "bor", "rahmat", "salom", "qayerda", "juda", "katta",
+	},
+	"vi": {
+		"và", "của", "là", "có", "không", "những", "các", "một", "được", "cho", "người", "trong", "đã", "này", "với", "cảm",
+		"ơn", "xin", "chào", "vâng", "rất", "ở", "đâu", "tôi", "bạn",
+	},
+	"yo": {
+		"ni", "ti", "àti", "o", "kò", "sí", "fún", "pé", "mo", "a", "wọn", "ẹ", "ṣé", "e", "òun", "jẹ",
+		"wà", "báwo", "kú",
+	},
+	"zh": {
+		"的", "一", "是", "不", "了", "在", "人", "有", "我", "他", "这", "个", "们", "中", "来", "上",
+		"大", "为", "和", "国", "地", "到", "以", "说", "时", "要", "就", "出", "会", "也", "你", "对",
+		"谢", "么", "吗", "没", "很", "好",
+	},
+	"zh-Latn": {
+		"de", "shi", "wo", "ni", "ta", "bu", "le", "zai", "you", "zhe", "ge", "men", "hao", "xiexie", "nihao", "shenme",
+		"nali", "hen", "ma", "women",
+	},
+	"zu": {
+		"ukuthi", "futhi", "kodwa", "ngoba", "uma", "nje", "yebo", "cha", "ngiyabonga", "sawubona", "kakhulu", "kuphi", "kanjani",
+	},
+}
+
+// frequentWordSets holds the words of frequentWords as sets
+var frequentWordSets = wordSets()
+
+func wordSets() map[string]map[string]bool {
+	sets := make(map[string]map[string]bool, len(frequentWords))
+	for k, words := range frequentWords {
+		sets[k] = make(map[string]bool, len(words))
+		for _, w := range words {
+			sets[k][w] = true
+		}
+	}
+	return sets
+}
+
+// unspacedScripts are written without spaces between words
+var unspacedScripts = []*unicode.RangeTable{unicode.Thai, unicode.Lao, unicode.Khmer, unicode.Myanmar}
+
+// unspacedWords holds the frequent words written in unspacedScripts, which
+// are the only words a run of those scripts can be split into
+var unspacedWords, maxUnspacedWord = unspacedDictionary()
+
+func unspacedDictionary() (map[string]bool, int) {
+	dict := make(map[string]bool)
+	var longest int
+	for _, words := range frequentWords {
+		for _, w := range words {
+			runes := []rune(w)
+			if unicode.In(runes[0], unspacedScripts...) {
+				dict[w] = true
+				if len(runes) > longest {
+					longest = len(runes)
+				}
+			}
+		}
+	}
+	return dict, longest
+}
+
+// tokens splits text into lowercase words where toTrigramChar sees a word
+// break, so the words and the n-grams agree on where words end
+//
+// Han and kana characters are words of their own, and runs of scripts written
+// without spaces, such as Thai, are split into frequent words, taking the
+// longest at each point; a character that starts no frequent word stands alone
+func tokens(text string) []string {
+	var words []string
+	for _, field := range strings.FieldsFunc(strings.ToLower(text), isWordSeparator) {
+		words = appendSegments(words, []rune(field))
+	}
+	return words
+}
+
+func appendSegments(words []string, runes []rune) []string {
+	start := 0
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana):
+			words = appendWord(words, runes[start:i])
+			words = append(words, string(r))
+			i++
+			start = i
+		case unicode.In(r, unspacedScripts...):
+			words = appendWord(words, runes[start:i])
+			n := unspacedWordAt(runes[i:])
+			words = append(words, string(runes[i:i+n]))
+			i += n
+			start = i
+		default:
+			i++
+		}
+	}
+	return appendWord(words, runes[start:])
+}
+
+func appendWord(words []string, runes []rune) []string {
+	if len(runes) == 0 {
+		return words
+	}
+	return append(words, string(runes))
+}
+
+// unspacedWordAt returns the length of the longest frequent word that runes
+// starts with, or 1 if there is none
+func unspacedWordAt(runes []rune) int {
+	n := maxUnspacedWord
+	if n > len(runes) {
+		n = len(runes)
+	}
+	for ; n > 1; n-- {
+		if unspacedWords[string(runes[:n])] {
+			return n
+		}
+	}
+	return 1
+}
+
+func matchWords(langName string, words []string, matches map[string]int, set map[string]bool) {
+	for _, w := range words {
+		if set[w] {
+			matches[langName] += wordCountFactor
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package getlang
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	tests := map[string][]string{
+		"Merci beaucoup!":        {"merci", "beaucoup"},
+		"Où est-ce, l'hôtel?":    {"où", "est", "ce", "l", "hôtel"},
+		"¿Dónde está el baño?":   {"dónde", "está", "el", "baño"},
+		"我们是中国人":                 {"我", "们", "是", "中", "国", "人"},
+		"東京のtower":               {"東", "京", "の", "tower"},
+		"ขอบคุณครับ":             {"ขอบคุณ", "ครับ"},
+		"สวัสดีชาวโลก":           {"สวัสดี", "ช", "า", "ว", "โ", "ล", "ก"},
+		"Hello ขอบคุณ, 谢谢 world": {"hello", "ขอบคุณ", "谢", "谢", "world"},
+		"  ... ":                 nil,
+	}
+	for text, expected := range tests {
+		assert.Equal(t, expected, tokens(text), text)
+	}
+}
+
+func TestTokensAgreeWithNgrams(t *testing.T) {
+	text := "Ich möchte (eine) Tasse Kaffee—mit Milch & Zucker, bitte..."
+
+	for _, w := range tokens(text) {
+		assert.Contains(t, countedNgrams(text, len([]rune(w))+2), " "+w+" ")
+	}
+}
+
+func TestFrequentWordsAreLowercaseNFC(t *testing.T) {
+	for k, words := range frequentWords {
+		for _, w := range words {
+			assert.Equal(t, []string{norm.NFC.String(strings.ToLower(w))}, tokens(w), k)
+		}
+	}
+}
+
+func TestFrequentWordsHaveCandidates(t *testing.T) {
+	for k := range frequentWords {
+		var found bool
+		for _, candidates := range scriptLanguages {
+			for _, c := range candidates {
+				found = found || c == k
+			}
+		}
+		assert.True(t, found, k)
+	}
+}
+
+func TestWordsScoreLanguagesAloneInTheirScript(t *testing.T) {
+	for text, lang := range map[string]string{
+		"ขอบคุณครับ":          "th",
+		"धन्यवाद, बहुत अच्छा": "hi",
+		"ありがとうございます":          "ja",
+	} {
+		without := matchAll(text, TrigramModel(), false)
+		with := matchAll(text, TrigramModel(), true)
+
+		info, _ := Detector{Words: true}.FromString(text)
+
+		assert.True(t, with[lang] > without[lang], text)
+		assert.Equal(t, lang, info.LanguageCode(), text)
+	}
+}
+
+func TestWordsClassifyShortQueries(t *testing.T) {
+	tests := map[string]string{
+		"merci beaucoup":     "fr",
+		"danke schön":        "de",
+		"dank je wel":        "nl",
+		"dziękuję bardzo":    "pl",
+		"où est la gare":     "fr",
+		"dónde está el baño": "es",
+		"wie geht es dir":    "de",
+	}
+	for text, lang := range tests {
+		info, err := Detector{Words: true}.FromString(text)
+
+		assert.Nil(t, err)
+		assert.Equal(t, lang, info.LanguageCode(), text)
+		assert.True(t, info.Confidence() > 0.85, text)
+	}
+}
+
+func TestDetectionIsRepeatable(t *testing.T) {
+	first := FromString("merci beaucoup")
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, first, FromString("merci beaucoup"))
+	}
+}
+
+func TestWordsAreOptional(t *testing.T) {
+	info, _ := Detector{}.FromString("merci beaucoup")
+
+	assert.Equal(t, FromString("merci beaucoup"), info)
+	assert.True(t, info.Confidence() < 0.5)
+}
+
+func TestWordsKeepFullSentences(t *testing.T) {
+	assert.Equal(t, len(accuracyCorpus), correctlyClassified(Detector{Words: true}, 0))
+	assert.True(t, correctlyClassified(Detector{Words: true}, 2) > correctlyClassified(Detector{}, 2))
+}
+
+func TestWordsChangeShortQueries(t *testing.T) {
+	tests := map[string]string{
+		"merci beaucoup": "fr",
+		"東京駅":            "ja",
+	}
+	for text, lang := range tests {
+		without, _ := Detector{}.FromString(text)
+		with, _ := Detector{Words: true}.FromString(text)
+
+		assert.NotEqual(t, lang, without.LanguageCode(), text)
+		assert.Equal(t, lang, with.LanguageCode(), text)
+	}
+}